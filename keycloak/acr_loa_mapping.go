@@ -0,0 +1,128 @@
+package keycloak
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+// The ACR to LoA (level of authentication) mapping is stored as a JSON object in the "acr.loa.map" attribute of realms
+// and clients, for example {"silver":1,"gold":2}
+const (
+	AcrLoaMapAttribute = "acr.loa.map"
+
+	// config key of the "Condition - Level of Authentication" authenticator
+	loaConditionLevelConfigKey = "loa-condition-level"
+)
+
+func ParseAcrLoaMapping(acrLoaMap string) (map[string]int, error) {
+	mapping := map[string]int{}
+	if acrLoaMap == "" {
+		return mapping, nil
+	}
+
+	// older versions of the admin console stored the levels as strings
+	var rawMapping map[string]interface{}
+	err := json.Unmarshal([]byte(acrLoaMap), &rawMapping)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse ACR to LoA mapping %s: %v", acrLoaMap, err)
+	}
+
+	for acr, rawLevel := range rawMapping {
+		level, err := strconv.Atoi(fmt.Sprint(rawLevel))
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse level of authentication %v for ACR %s", rawLevel, acr)
+		}
+		mapping[acr] = level
+	}
+
+	return mapping, nil
+}
+
+func FormatAcrLoaMapping(mapping map[string]int) (string, error) {
+	if len(mapping) == 0 {
+		return "", nil
+	}
+
+	acrLoaMap, err := json.Marshal(mapping)
+	if err != nil {
+		return "", err
+	}
+
+	return string(acrLoaMap), nil
+}
+
+func (keycloakClient *KeycloakClient) getRealmAcrLoaMapping(ctx context.Context, realmId string) (map[string]int, error) {
+	attributes, err := keycloakClient.GetRealmAttributes(ctx, realmId)
+	if err != nil {
+		return nil, err
+	}
+
+	return ParseAcrLoaMapping(attributes[AcrLoaMapAttribute])
+}
+
+// Default ACR values of a client must either be a level of authentication, or be mapped to one by the client or realm.
+func (keycloakClient *KeycloakClient) validateOpenidClientDefaultAcrValues(ctx context.Context, client *OpenidClient) error {
+	clientMapping, err := ParseAcrLoaMapping(client.Attributes.AcrLoaMap)
+	if err != nil {
+		return err
+	}
+
+	var realmMapping map[string]int
+	for _, acr := range client.Attributes.DefaultAcrValues {
+		if _, err := strconv.Atoi(acr); err == nil {
+			continue
+		}
+
+		if _, ok := clientMapping[acr]; ok {
+			continue
+		}
+
+		if realmMapping == nil {
+			realmMapping, err = keycloakClient.getRealmAcrLoaMapping(ctx, client.RealmId)
+			if err != nil {
+				return err
+			}
+		}
+
+		if _, ok := realmMapping[acr]; !ok {
+			return fmt.Errorf("validation error: default ACR value %s is not a level of authentication, and is not mapped to one by the client or realm", acr)
+		}
+	}
+
+	return nil
+}
+
+// ValidateAuthenticationExecutionConfig checks that the level of authentication used by a conditional level of
+// authentication execution is one of the levels mapped by the realm. Only the mapping of the realm is checked, mappings of
+// clients are not. Realms without a mapping are not validated at all, so levels that are only mapped by clients can be
+// used by leaving the mapping of the realm empty.
+func (keycloakClient *KeycloakClient) ValidateAuthenticationExecutionConfig(ctx context.Context, config *AuthenticationExecutionConfig) error {
+	levelString, ok := config.Config[loaConditionLevelConfigKey]
+	if !ok {
+		return nil
+	}
+
+	level, err := strconv.Atoi(levelString)
+	if err != nil {
+		return fmt.Errorf("validation error: %s must be a number, got %s", loaConditionLevelConfigKey, levelString)
+	}
+
+	mapping, err := keycloakClient.getRealmAcrLoaMapping(ctx, config.RealmId)
+	if err != nil {
+		return err
+	}
+
+	if len(mapping) == 0 {
+		return nil
+	}
+
+	for _, mappedLevel := range mapping {
+		if mappedLevel == level {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("validation error: level of authentication %d is not mapped to any ACR value in realm %s", level, config.RealmId)
+}
@@ -0,0 +1,175 @@
+package keycloak
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// AdminPermissionsClientId is the client keycloak creates when fine-grained admin permissions v2 are enabled for a realm.
+const AdminPermissionsClientId = "admin-permissions"
+
+// AdminPermissionsResourceTypeScopes lists the scopes that are available for each admin permissions v2 resource type.
+var AdminPermissionsResourceTypeScopes = map[string][]string{
+	"Users":   {"view", "manage", "manage-group-membership", "map-roles", "impersonate", "reset-password"},
+	"Groups":  {"view", "manage", "view-members", "manage-members", "manage-membership", "impersonate-members"},
+	"Clients": {"view", "manage", "map-roles", "map-roles-client-scope", "map-roles-composite"},
+	"Roles":   {"map-role", "map-role-client-scope", "map-role-composite"},
+}
+
+type AdminPermission struct {
+	Id               string
+	RealmId          string
+	ResourceServerId string
+	Name             string
+	Description      string
+	DecisionStrategy string
+	ResourceType     string
+	Resources        []string // ids of the users, groups, clients or roles. when empty, the permission applies to all of them
+	Scopes           []string // scope names
+	Policies         []string
+}
+
+func (keycloakClient *KeycloakClient) GetAdminPermissionsClient(ctx context.Context, realmId string) (*GenericClient, error) {
+	versionOk, err := keycloakClient.VersionIsGreaterThanOrEqualTo(ctx, Version_26_2)
+	if err != nil {
+		return nil, err
+	}
+	if !versionOk {
+		return nil, fmt.Errorf("validation error: admin permissions v2 are only supported by Keycloak 26.2 and later")
+	}
+
+	client, err := keycloakClient.GetGenericClientByClientId(ctx, realmId, AdminPermissionsClientId)
+	if err != nil {
+		return nil, fmt.Errorf("validation error: admin permissions v2 are not enabled for realm %s: %s", realmId, err)
+	}
+
+	return client, nil
+}
+
+func (keycloakClient *KeycloakClient) ValidateAdminPermission(ctx context.Context, permission *AdminPermission) error {
+	scopes, ok := AdminPermissionsResourceTypeScopes[permission.ResourceType]
+	if !ok {
+		return fmt.Errorf("validation error: unknown admin permissions resource type %s", permission.ResourceType)
+	}
+
+	if len(permission.Scopes) == 0 {
+		return fmt.Errorf("validation error: at least one scope is required for an admin permission")
+	}
+
+	for _, scope := range permission.Scopes {
+		if !contains(scopes, scope) {
+			return fmt.Errorf("validation error: scope %s is not valid for resource type %s, must be one of %v", scope, permission.ResourceType, scopes)
+		}
+	}
+
+	return nil
+}
+
+func (keycloakClient *KeycloakClient) NewAdminPermission(ctx context.Context, permission *AdminPermission) error {
+	representation := permission.toAuthorizationPermission()
+
+	body, _, err := keycloakClient.post(ctx, fmt.Sprintf("/realms/%s/clients/%s/authz/resource-server/permission/scope", permission.RealmId, permission.ResourceServerId), representation)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(body, representation)
+	if err != nil {
+		return err
+	}
+
+	permission.Id = representation.Id
+
+	return nil
+}
+
+func (keycloakClient *KeycloakClient) GetAdminPermission(ctx context.Context, realmId, resourceServerId, id string) (*AdminPermission, error) {
+	var representation OpenidClientAuthorizationPermission
+	var policies []OpenidClientAuthorizationPolicy
+	var resources []OpenidClientAuthorizationResource
+	var scopes []OpenidClientAuthorizationScope
+
+	err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/clients/%s/authz/resource-server/permission/%s", realmId, resourceServerId, id), &representation, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	err = keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/clients/%s/authz/resource-server/policy/%s/associatedPolicies", realmId, resourceServerId, id), &policies, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	err = keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/clients/%s/authz/resource-server/permission/%s/resources", realmId, resourceServerId, id), &resources, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	err = keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/clients/%s/authz/resource-server/permission/%s/scopes", realmId, resourceServerId, id), &scopes, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	permission := &AdminPermission{
+		Id:               representation.Id,
+		RealmId:          realmId,
+		ResourceServerId: resourceServerId,
+		Name:             representation.Name,
+		Description:      representation.Description,
+		DecisionStrategy: representation.DecisionStrategy,
+		ResourceType:     representation.ResourceType,
+	}
+
+	for _, policy := range policies {
+		permission.Policies = append(permission.Policies, policy.Id)
+	}
+
+	// resources are named after the id of the object they protect. a permission for all objects of a type
+	// is associated with a single resource named after the resource type instead
+	for _, resource := range resources {
+		if resource.Name == permission.ResourceType {
+			continue
+		}
+		permission.Resources = append(permission.Resources, resource.Name)
+	}
+
+	for _, scope := range scopes {
+		permission.Scopes = append(permission.Scopes, scope.Name)
+	}
+
+	return permission, nil
+}
+
+func (keycloakClient *KeycloakClient) UpdateAdminPermission(ctx context.Context, permission *AdminPermission) error {
+	return keycloakClient.put(ctx, fmt.Sprintf("/realms/%s/clients/%s/authz/resource-server/permission/scope/%s", permission.RealmId, permission.ResourceServerId, permission.Id), permission.toAuthorizationPermission())
+}
+
+func (keycloakClient *KeycloakClient) DeleteAdminPermission(ctx context.Context, realmId, resourceServerId, id string) error {
+	return keycloakClient.DeleteOpenidClientAuthorizationPermission(ctx, realmId, resourceServerId, id)
+}
+
+func (permission *AdminPermission) toAuthorizationPermission() *OpenidClientAuthorizationPermission {
+	resources := permission.Resources
+	if resources == nil {
+		resources = []string{}
+	}
+
+	policies := permission.Policies
+	if policies == nil {
+		policies = []string{}
+	}
+
+	return &OpenidClientAuthorizationPermission{
+		Id:               permission.Id,
+		RealmId:          permission.RealmId,
+		ResourceServerId: permission.ResourceServerId,
+		Name:             permission.Name,
+		Description:      permission.Description,
+		DecisionStrategy: permission.DecisionStrategy,
+		Policies:         policies,
+		Resources:        resources,
+		Scopes:           permission.Scopes,
+		Type:             "scope",
+		ResourceType:     permission.ResourceType,
+	}
+}
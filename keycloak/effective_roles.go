@@ -0,0 +1,241 @@
+package keycloak
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+)
+
+// EffectiveRole is a role that a user or group ends up with, along with how it was granted
+type EffectiveRole struct {
+	Id        string
+	Name      string
+	ClientId  string // the client id of client roles, empty for realm roles
+	Composite bool
+	// SourcePath leads from the subject to the role, such as ["group:/engineering", "role:admin"] when the role is a composite of the admin
+	// realm role granted by the engineering group. It is empty when Keycloak reports a role that could not be traced.
+	SourcePath []string
+}
+
+type effectiveRoleSeed struct {
+	roleMapping *RoleMapping
+	source      string
+}
+
+type effectiveRoleQueueItem struct {
+	role       *Role
+	clientId   string
+	sourcePath []string
+}
+
+func (keycloakClient *KeycloakClient) GetUserEffectiveRoles(ctx context.Context, realmId, userId string) ([]*EffectiveRole, error) {
+	roleMapping, err := keycloakClient.GetUserRoleMappings(ctx, realmId, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	seeds := []*effectiveRoleSeed{{roleMapping: roleMapping, source: "user"}}
+
+	groups, err := keycloakClient.GetUserGroups(ctx, realmId, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, group := range groups {
+		groupSeeds, err := keycloakClient.getGroupEffectiveRoleSeeds(ctx, realmId, group.Id)
+		if err != nil {
+			return nil, err
+		}
+
+		seeds = append(seeds, groupSeeds...)
+	}
+
+	return keycloakClient.expandEffectiveRoles(ctx, realmId, seeds, fmt.Sprintf("/realms/%s/users/%s/role-mappings", realmId, userId))
+}
+
+func (keycloakClient *KeycloakClient) GetGroupEffectiveRoles(ctx context.Context, realmId, groupId string) ([]*EffectiveRole, error) {
+	seeds, err := keycloakClient.getGroupEffectiveRoleSeeds(ctx, realmId, groupId)
+	if err != nil {
+		return nil, err
+	}
+
+	return keycloakClient.expandEffectiveRoles(ctx, realmId, seeds, fmt.Sprintf("/realms/%s/groups/%s/role-mappings", realmId, groupId))
+}
+
+// members of a group also receive the roles of its parent groups
+func (keycloakClient *KeycloakClient) getGroupEffectiveRoleSeeds(ctx context.Context, realmId, groupId string) ([]*effectiveRoleSeed, error) {
+	var seeds []*effectiveRoleSeed
+
+	for id := groupId; id != ""; {
+		group, err := keycloakClient.GetGroup(ctx, realmId, id)
+		if err != nil {
+			return nil, err
+		}
+
+		roleMapping, err := keycloakClient.GetGroupRoleMappings(ctx, realmId, group.Id)
+		if err != nil {
+			return nil, err
+		}
+
+		seeds = append(seeds, &effectiveRoleSeed{roleMapping: roleMapping, source: "group:" + group.Path})
+
+		id = group.ParentId
+	}
+
+	return seeds, nil
+}
+
+// expandEffectiveRoles follows composite roles breadth first, so that every role is reported with the shortest path that grants it.
+// The composite realm roles and client roles Keycloak reports under roleMappingsPath are added as well, in case one of them was
+// granted in a way that was not traced. Client roles are only checked for the clients that were seen while expanding.
+func (keycloakClient *KeycloakClient) expandEffectiveRoles(ctx context.Context, realmId string, seeds []*effectiveRoleSeed, roleMappingsPath string) ([]*EffectiveRole, error) {
+	clientIds := map[string]string{}
+
+	var queue []*effectiveRoleQueueItem
+	for _, seed := range seeds {
+		if seed.roleMapping == nil {
+			continue
+		}
+
+		for _, role := range seed.roleMapping.RealmMappings {
+			queue = append(queue, &effectiveRoleQueueItem{role: role, sourcePath: []string{seed.source}})
+		}
+		for clientId, clientMapping := range seed.roleMapping.ClientMappings {
+			clientIds[clientMapping.Id] = clientId
+			for _, role := range clientMapping.Mappings {
+				queue = append(queue, &effectiveRoleQueueItem{role: role, clientId: clientId, sourcePath: []string{seed.source}})
+			}
+		}
+	}
+
+	visited := map[string]bool{}
+	var effectiveRoles []*EffectiveRole
+
+	for len(queue) != 0 {
+		item := queue[0]
+		queue = queue[1:]
+
+		if visited[item.role.Id] {
+			continue
+		}
+		visited[item.role.Id] = true
+
+		effectiveRoles = append(effectiveRoles, &EffectiveRole{
+			Id:         item.role.Id,
+			Name:       item.role.Name,
+			ClientId:   item.clientId,
+			Composite:  item.role.Composite,
+			SourcePath: item.sourcePath,
+		})
+
+		if !item.role.Composite {
+			continue
+		}
+
+		composites, err := keycloakClient.getEffectiveRoleComposites(ctx, realmId, item)
+		if err != nil {
+			return nil, err
+		}
+
+		label := "role:" + item.role.Name
+		if item.clientId != "" {
+			label = "role:" + item.clientId + "/" + item.role.Name
+		}
+
+		for _, composite := range composites {
+			if visited[composite.Id] {
+				continue
+			}
+
+			clientId := ""
+			if composite.ClientRole {
+				clientId, err = keycloakClient.getEffectiveRoleClientId(ctx, realmId, composite.ContainerId, clientIds)
+				if err != nil {
+					return nil, err
+				}
+			}
+
+			sourcePath := append(append([]string{}, item.sourcePath...), label)
+			queue = append(queue, &effectiveRoleQueueItem{role: composite, clientId: clientId, sourcePath: sourcePath})
+		}
+	}
+
+	var realmRoles []*Role
+	err := keycloakClient.get(ctx, roleMappingsPath+"/realm/composite", &realmRoles, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	effectiveRoles = appendUntracedEffectiveRoles(effectiveRoles, visited, realmRoles, "")
+
+	// clients are checked in a stable order, so that untraced roles are always reported in the same order
+	var ids []string
+	for id := range clientIds {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	for _, id := range ids {
+		var clientRoles []*Role
+		err := keycloakClient.get(ctx, fmt.Sprintf("%s/clients/%s/composite", roleMappingsPath, id), &clientRoles, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		effectiveRoles = appendUntracedEffectiveRoles(effectiveRoles, visited, clientRoles, clientIds[id])
+	}
+
+	return effectiveRoles, nil
+}
+
+// client roles are expanded through the roles endpoint of their client, realm roles through roles-by-id
+func (keycloakClient *KeycloakClient) getEffectiveRoleComposites(ctx context.Context, realmId string, item *effectiveRoleQueueItem) ([]*Role, error) {
+	if item.clientId == "" || item.role.ContainerId == "" {
+		item.role.RealmId = realmId
+		return keycloakClient.GetRoleComposites(ctx, item.role)
+	}
+
+	var composites []*Role
+	err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/clients/%s/roles/%s/composites", realmId, item.role.ContainerId, url.PathEscape(item.role.Name)), &composites, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return composites, nil
+}
+
+func appendUntracedEffectiveRoles(effectiveRoles []*EffectiveRole, visited map[string]bool, roles []*Role, clientId string) []*EffectiveRole {
+	for _, role := range roles {
+		if visited[role.Id] {
+			continue
+		}
+		visited[role.Id] = true
+
+		effectiveRoles = append(effectiveRoles, &EffectiveRole{
+			Id:         role.Id,
+			Name:       role.Name,
+			ClientId:   clientId,
+			Composite:  role.Composite,
+			SourcePath: []string{},
+		})
+	}
+
+	return effectiveRoles
+}
+
+// the composites of a role only carry the internal id of the client their roles belong to
+func (keycloakClient *KeycloakClient) getEffectiveRoleClientId(ctx context.Context, realmId, id string, clientIds map[string]string) (string, error) {
+	if clientId, ok := clientIds[id]; ok {
+		return clientId, nil
+	}
+
+	client, err := keycloakClient.GetGenericClient(ctx, realmId, id)
+	if err != nil {
+		return "", err
+	}
+
+	clientIds[id] = client.ClientId
+
+	return client.ClientId, nil
+}
@@ -8,6 +8,7 @@ import (
 type ApiError struct {
 	Code    int
 	Message string
+	Body    []byte
 }
 
 func (e *ApiError) Error() string {
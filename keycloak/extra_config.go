@@ -58,10 +58,17 @@ func marshalExtraConfig(reflectValue reflect.Value, extraConfig map[string]inter
 	}
 
 	for i := 0; i < reflectValue.NumField(); i++ {
-		jsonKey := strings.Split(reflectValue.Type().Field(i).Tag.Get("json"), ",")[0]
+		jsonTag := strings.Split(reflectValue.Type().Field(i).Tag.Get("json"), ",")
+		jsonKey := jsonTag[0]
 		if jsonKey != "-" {
 			field := reflectValue.Field(i)
 			if field.IsValid() && field.CanSet() {
+				// empty attributes tagged with omitempty are left out, so a value within extraConfig is sent instead.
+				// this is used to set attributes to an empty string only when they have to be removed from keycloak
+				if len(jsonTag) > 1 && jsonTag[1] == "omitempty" && (field.IsZero() || field.Kind() == reflect.Slice && field.Len() == 0) {
+					continue
+				}
+
 				if field.Kind() == reflect.String {
 					out[jsonKey] = field.String()
 				} else if field.Kind() == reflect.Bool {
@@ -14,9 +14,50 @@ type GenericClient struct {
 
 	Enabled     bool   `json:"enabled"`
 	Description string `json:"description"`
+
+	RootUrl  string `json:"rootUrl"`
+	BaseUrl  string `json:"baseUrl"`
+	AdminUrl string `json:"adminUrl"`
+
+	PublicClient              bool              `json:"publicClient"`
+	BearerOnly                bool              `json:"bearerOnly"`
+	ValidRedirectUris         []string          `json:"redirectUris"`
+	WebOrigins                []string          `json:"webOrigins"`
+	FullScopeAllowed          bool              `json:"fullScopeAllowed"`
+	StandardFlowEnabled       bool              `json:"standardFlowEnabled"`
+	ImplicitFlowEnabled       bool              `json:"implicitFlowEnabled"`
+	DirectAccessGrantsEnabled bool              `json:"directAccessGrantsEnabled"`
+	ServiceAccountsEnabled    bool              `json:"serviceAccountsEnabled"`
+	Attributes                map[string]string `json:"attributes,omitempty"`
+}
+
+// ValidateGenericClient checks that the protocol of the client is provided by a login protocol that is installed on the server,
+// such as docker-v2 or a protocol added by an extension
+func (keycloakClient *KeycloakClient) ValidateGenericClient(ctx context.Context, client *GenericClient) error {
+	serverInfo, err := keycloakClient.GetServerInfo(ctx)
+	if err != nil {
+		return err
+	}
+
+	if !serverInfo.providerInstalled("login-protocol", client.Protocol) {
+		return fmt.Errorf("validation error: login protocol \"%s\" does not exist on the server, installed providers: %s", client.Protocol, serverInfo.getInstalledProvidersNames("login-protocol"))
+	}
+
+	return nil
+}
+
+func (keycloakClient *KeycloakClient) NewGenericClient(ctx context.Context, client *GenericClient) error {
+	_, location, err := keycloakClient.post(ctx, fmt.Sprintf("/realms/%s/clients", client.RealmId), client)
+	if err != nil {
+		return err
+	}
+
+	client.Id = getIdFromLocationHeader(location)
+
+	return nil
 }
 
-func (keycloakClient *KeycloakClient) listGenericClients(ctx context.Context, realmId string) ([]*GenericClient, error) {
+func (keycloakClient *KeycloakClient) ListGenericClients(ctx context.Context, realmId string) ([]*GenericClient, error) {
 	var clients []*GenericClient
 
 	err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/clients", realmId), &clients, nil)
@@ -66,3 +107,17 @@ func (keycloakClient *KeycloakClient) GetGenericClientByClientId(ctx context.Con
 
 	return &client, nil
 }
+
+func (keycloakClient *KeycloakClient) UpdateGenericClient(ctx context.Context, client *GenericClient) error {
+	return keycloakClient.put(ctx, fmt.Sprintf("/realms/%s/clients/%s", client.RealmId, client.Id), client)
+}
+
+func (keycloakClient *KeycloakClient) DeleteGenericClient(ctx context.Context, realmId, id string) error {
+	return keycloakClient.delete(ctx, fmt.Sprintf("/realms/%s/clients/%s", realmId, id), nil)
+}
+
+// GetClientInstallationProvider returns the document an installation provider generates for a client, such as a keycloak.json
+// adapter configuration or SAML metadata
+func (keycloakClient *KeycloakClient) GetClientInstallationProvider(ctx context.Context, realmId, id string, providerId string) ([]byte, error) {
+	return keycloakClient.getRaw(ctx, fmt.Sprintf("/realms/%s/clients/%s/installation/providers/%s", realmId, id, providerId), nil)
+}
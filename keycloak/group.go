@@ -3,6 +3,8 @@ package keycloak
 import (
 	"context"
 	"fmt"
+	"net/url"
+	"strconv"
 	"strings"
 )
 
@@ -16,47 +18,42 @@ type Group struct {
 	RealmRoles  []string            `json:"realmRoles,omitempty"`
 	ClientRoles map[string][]string `json:"clientRoles,omitempty"`
 	Attributes  map[string][]string `json:"attributes"`
+
+	// Keycloak 23 and later only return the number of subgroups, which then have to be fetched separately
+	SubGroupCount int `json:"subGroupCount,omitempty"`
 }
 
 /*
  * There is no way to get a subgroup's parent ID using the Keycloak API (that I know of, PRs are welcome)
- * The best we can do is check subGroup's path with the group's path to figure out what sub-path to follow
- * until we find it.
+ * The parent's path is the group's path without the last segment, so the parent can be looked up by its path.
  */
 func (keycloakClient *KeycloakClient) groupParentId(ctx context.Context, group *Group) (string, error) {
 	// Check the path of the group being passed in.
 	// If there is only one group in the path, then this is a top-level group with no parentId
-	if group.Path == "/"+group.Name {
+	if group.Path == "/"+group.Name || group.Path == "/"+escapeGroupName(group.Name) {
 		return "", nil
 	}
 
-	groups, err := keycloakClient.ListGroupsWithName(ctx, group.RealmId, group.Name)
-	if err != nil {
-		return "", err
+	parentPath := strings.TrimSuffix(group.Path, "/"+group.Name)
+	if parentPath == group.Path {
+		// since Keycloak 23, slashes within group names are escaped in the path
+		parentPath = strings.TrimSuffix(group.Path, "/"+escapeGroupName(group.Name))
 	}
 
-	var parentGroup Group
-	if parentGroupId, found := findParentGroup(*group, groups, parentGroup); found {
-		return parentGroupId, nil
+	if parentPath == group.Path || parentPath == "" {
+		return "", fmt.Errorf("unable to determine parent ID for group with path %s", group.Path)
 	}
 
-	// maybe panic here?  this should never happen
-	return "", fmt.Errorf("unable to determine parent ID for group with path %s", group.Path)
-}
+	parentGroup, err := keycloakClient.getGroupByPath(ctx, group.RealmId, parentPath)
+	if err != nil {
+		return "", fmt.Errorf("unable to determine parent ID for group with path %s: %v", group.Path, err)
+	}
 
-func findParentGroup(group Group, ingroups []*Group, parentGroup Group) (string, bool) {
-	for _, grp := range ingroups {
-		if grp.Id == group.Id {
-			return parentGroup.Id, true
-		}
-		if strings.HasPrefix(group.Path, grp.Path+"/") {
+	return parentGroup.Id, nil
+}
 
-			if parentGroupId, found := findParentGroup(group, grp.SubGroups, *grp); found {
-				return parentGroupId, found
-			}
-		}
-	}
-	return "", false
+func escapeGroupName(name string) string {
+	return strings.ReplaceAll(name, "/", "~/")
 }
 
 func (keycloakClient *KeycloakClient) ValidateGroupMembers(usernames []interface{}) error {
@@ -128,7 +125,7 @@ func (keycloakClient *KeycloakClient) GetGroup(ctx context.Context, realmId, id
 }
 
 func (keycloakClient *KeycloakClient) GetGroupByName(ctx context.Context, realmId, name string) (*Group, error) {
-	var groups []Group
+	var groups []*Group
 
 	// We can't get a group by name, so we have to search for it
 	params := map[string]string{
@@ -145,11 +142,11 @@ func (keycloakClient *KeycloakClient) GetGroupByName(ctx context.Context, realmI
 	}
 
 	// The search may return more than 1 result even if there is a group exactly matching the search string
-	groupsPtr := make([]*Group, len(groups))
-	for i := range groups {
-		groupsPtr[i] = &groups[i]
+	group, err := keycloakClient.getGroupByDFS(ctx, realmId, name, groups)
+	if err != nil {
+		return nil, err
 	}
-	group := getGroupByDFS(name, groupsPtr)
+
 	if group != nil {
 		group.RealmId = realmId // it's important to set RealmId here because fetching the ParentId depends on it
 
@@ -170,17 +167,105 @@ func (keycloakClient *KeycloakClient) GetGroupByName(ctx context.Context, realmI
 Find group by name in groups returned by /groups?search=${group_name}
 If there are multiple groups match the name, it will return the first one it found, using DFS algorithm
 */
-func getGroupByDFS(groupName string, groups []*Group) *Group {
+func (keycloakClient *KeycloakClient) getGroupByDFS(ctx context.Context, realmId, groupName string, groups []*Group) (*Group, error) {
 	for _, group := range groups {
 		if groupName == group.Name {
-			return group
+			return group, nil
+		}
+
+		group.RealmId = realmId
+		subGroups, err := keycloakClient.GetSubGroups(ctx, group)
+		if err != nil {
+			return nil, err
+		}
+
+		groupFound, err := keycloakClient.getGroupByDFS(ctx, realmId, groupName, subGroups)
+		if err != nil {
+			return nil, err
 		}
-		groupFound := getGroupByDFS(groupName, group.SubGroups)
 		if groupFound != nil {
-			return groupFound
+			return groupFound, nil
 		}
 	}
-	return nil
+
+	return nil, nil
+}
+
+// GetGroupByPath returns the group with the given full path, such as /Engineering/Admins
+func (keycloakClient *KeycloakClient) GetGroupByPath(ctx context.Context, realmId, path string) (*Group, error) {
+	group, err := keycloakClient.getGroupByPath(ctx, realmId, path)
+	if err != nil {
+		return nil, err
+	}
+
+	parentId, err := keycloakClient.groupParentId(ctx, group)
+	if err != nil {
+		return nil, err
+	}
+
+	group.ParentId = parentId
+
+	return group, nil
+}
+
+func (keycloakClient *KeycloakClient) getGroupByPath(ctx context.Context, realmId, path string) (*Group, error) {
+	var group Group
+
+	segments := strings.Split(strings.TrimPrefix(path, "/"), "/")
+	for i, segment := range segments {
+		segments[i] = url.PathEscape(segment)
+	}
+
+	err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/group-by-path/%s", realmId, strings.Join(segments, "/")), &group, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	group.RealmId = realmId
+
+	return &group, nil
+}
+
+// GetGroupChildren returns the direct subgroups of a group, one page at a time
+func (keycloakClient *KeycloakClient) GetGroupChildren(ctx context.Context, realmId, groupId string) ([]*Group, error) {
+	var groups []*Group
+	var first, pagination int = 0, 100
+
+	for {
+		var iterationGroups []*Group
+
+		params := map[string]string{
+			"first": strconv.Itoa(first),
+			"max":   strconv.Itoa(pagination),
+		}
+
+		err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/groups/%s/children", realmId, groupId), &iterationGroups, params)
+		if err != nil {
+			return nil, err
+		}
+
+		groups = append(groups, iterationGroups...)
+
+		if len(iterationGroups) < pagination {
+			break
+		}
+		first += pagination
+	}
+
+	for _, group := range groups {
+		group.RealmId = realmId
+	}
+
+	return groups, nil
+}
+
+// GetSubGroups returns the subgroups of a group, fetching them separately when the server didn't inline them
+func (keycloakClient *KeycloakClient) GetSubGroups(ctx context.Context, group *Group) ([]*Group, error) {
+	if len(group.SubGroups) != 0 || group.SubGroupCount == 0 {
+		return group.SubGroups, nil
+	}
+
+	return keycloakClient.GetGroupChildren(ctx, group.RealmId, group.Id)
 }
 
 func (keycloakClient *KeycloakClient) UpdateGroup(ctx context.Context, group *Group) error {
@@ -228,6 +313,36 @@ func (keycloakClient *KeycloakClient) GetGroupMembers(ctx context.Context, realm
 	return users, nil
 }
 
+// GetGroupMemberCount counts the direct members of a group. Members are listed in brief representation and only their
+// ids are decoded, so large groups can be counted without fetching the details of every user.
+func (keycloakClient *KeycloakClient) GetGroupMemberCount(ctx context.Context, realmId, groupId string) (int, error) {
+	var count, first, pagination int = 0, 0, 100
+
+	for {
+		var iterationMembers []struct {
+			Id string `json:"id"`
+		}
+
+		params := map[string]string{
+			"briefRepresentation": "true",
+			"first":               strconv.Itoa(first),
+			"max":                 strconv.Itoa(pagination),
+		}
+
+		err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/groups/%s/members", realmId, groupId), &iterationMembers, params)
+		if err != nil {
+			return 0, err
+		}
+
+		count += len(iterationMembers)
+		if len(iterationMembers) < pagination {
+			return count, nil
+		}
+
+		first += pagination
+	}
+}
+
 func defaultGroupURL(realmName, groupId string) string {
 	return fmt.Sprintf("/realms/%s/default-groups/%s", realmName, groupId)
 }
@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"io/ioutil"
+	"mime/multipart"
 	"net/http"
 	"net/http/cookiejar"
 	"net/url"
@@ -35,6 +36,7 @@ type KeycloakClient struct {
 	additionalHeaders map[string]string
 	debug             bool
 	redHatSSO         bool
+	ownershipTag      string
 }
 
 type ClientCredentials struct {
@@ -60,7 +62,7 @@ var redHatSSO7VersionMap = map[int]string{
 	4: "9.0.17",
 }
 
-func NewKeycloakClient(ctx context.Context, url, basePath, clientId, clientSecret, realm, username, password string, initialLogin bool, clientTimeout int, caCert string, tlsInsecureSkipVerify bool, userAgent string, redHatSSO bool, additionalHeaders map[string]string) (*KeycloakClient, error) {
+func NewKeycloakClient(ctx context.Context, url, basePath, clientId, clientSecret, realm, username, password string, initialLogin bool, clientTimeout int, caCert string, tlsInsecureSkipVerify bool, userAgent string, redHatSSO bool, additionalHeaders map[string]string, ownershipTag string) (*KeycloakClient, error) {
 	clientCredentials := &ClientCredentials{
 		ClientId:     clientId,
 		ClientSecret: clientSecret,
@@ -93,6 +95,7 @@ func NewKeycloakClient(ctx context.Context, url, basePath, clientId, clientSecre
 		userAgent:         userAgent,
 		redHatSSO:         redHatSSO,
 		additionalHeaders: additionalHeaders,
+		ownershipTag:      ownershipTag,
 	}
 
 	if keycloakClient.initialLogin {
@@ -293,7 +296,8 @@ func (keycloakClient *KeycloakClient) addRequestHeaders(request *http.Request) {
 		request.Header.Set("User-Agent", keycloakClient.userAgent)
 	}
 
-	if request.Method == http.MethodPost || request.Method == http.MethodPut || request.Method == http.MethodDelete {
+	// multipart requests carry their own content type
+	if (request.Method == http.MethodPost || request.Method == http.MethodPut || request.Method == http.MethodDelete) && request.Header.Get("Content-type") == "" {
 		request.Header.Set("Content-type", "application/json")
 	}
 }
@@ -383,6 +387,7 @@ func (keycloakClient *KeycloakClient) sendRequest(ctx context.Context, request *
 		return nil, "", &ApiError{
 			Code:    response.StatusCode,
 			Message: errorMessage,
+			Body:    responseBody,
 		}
 	}
 
@@ -430,6 +435,46 @@ func (keycloakClient *KeycloakClient) sendRaw(ctx context.Context, path string,
 	return body, err
 }
 
+func (keycloakClient *KeycloakClient) postMultipart(ctx context.Context, path string, fields map[string]string, fileName string, file []byte) ([]byte, error) {
+	resourceUrl := keycloakClient.baseUrl + apiUrl + path
+
+	var payload bytes.Buffer
+	writer := multipart.NewWriter(&payload)
+
+	for key, value := range fields {
+		err := writer.WriteField(key, value)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	part, err := writer.CreateFormFile("file", fileName)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = part.Write(file)
+	if err != nil {
+		return nil, err
+	}
+
+	err = writer.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, resourceUrl, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	request.Header.Set("Content-type", writer.FormDataContentType())
+
+	body, _, err := keycloakClient.sendRequest(ctx, request, payload.Bytes())
+
+	return body, err
+}
+
 func (keycloakClient *KeycloakClient) post(ctx context.Context, path string, requestBody interface{}) ([]byte, string, error) {
 	resourceUrl := keycloakClient.baseUrl + apiUrl + path
 
@@ -80,6 +80,35 @@ type OpenidClientAttributes struct {
 	Oauth2DeviceCodeLifespan              string                           `json:"oauth2.device.code.lifespan,omitempty"`
 	Oauth2DevicePollingInterval           string                           `json:"oauth2.device.polling.interval,omitempty"`
 	PostLogoutRedirectUris                types.KeycloakSliceHashDelimited `json:"post.logout.redirect.uris,omitempty"`
+
+	// CIBA and PAR
+	CibaGrantEnabled                          types.KeycloakBoolQuoted `json:"oidc.ciba.grant.enabled"`
+	CibaBackchannelTokenDeliveryMode          string                   `json:"ciba.backchannel.token.delivery.mode,omitempty"`
+	CibaBackchannelClientNotificationEndpoint string                   `json:"ciba.backchannel.client.notification.endpoint,omitempty"`
+	CibaBackchannelAuthRequestSigningAlg      string                   `json:"ciba.backchannel.auth.request.signing.alg,omitempty"`
+	RequirePushedAuthorizationRequests        types.KeycloakBoolQuoted `json:"require.pushed.authorization.requests"`
+
+	// client-jwt and client-x509 authenticators
+	UseJwksUrl                      types.KeycloakBoolQuoted `json:"use.jwks.url,omitempty"`
+	JwksUrl                         string                   `json:"jwks.url,omitempty"`
+	TokenEndpointAuthSigningAlg     string                   `json:"token.endpoint.auth.signing.alg,omitempty"`
+	X509SubjectDn                   string                   `json:"x509.subjectdn,omitempty"`
+	X509AllowRegexPatternComparison types.KeycloakBoolQuoted `json:"x509.allow.regex.pattern.comparison,omitempty"`
+
+	// token signing and encryption
+	AccessTokenSignedResponseAlg          string                   `json:"access.token.signed.response.alg,omitempty"`
+	IdTokenSignedResponseAlg              string                   `json:"id.token.signed.response.alg,omitempty"`
+	IdTokenEncryptedResponseAlg           string                   `json:"id.token.encrypted.response.alg,omitempty"`
+	IdTokenEncryptedResponseEnc           string                   `json:"id.token.encrypted.response.enc,omitempty"`
+	UserInfoResponseSignatureAlg          string                   `json:"user.info.response.signature.alg,omitempty"`
+	RequestObjectSignatureAlg             string                   `json:"request.object.signature.alg,omitempty"`
+	RequestObjectRequired                 string                   `json:"request.object.required,omitempty"`
+	AuthorizationSignedResponseAlg        string                   `json:"authorization.signed.response.alg,omitempty"`
+	TlsClientCertificateBoundAccessTokens types.KeycloakBoolQuoted `json:"tls.client.certificate.bound.access.tokens,omitempty"`
+
+	// step-up authentication
+	AcrLoaMap        string                           `json:"acr.loa.map,omitempty"`
+	DefaultAcrValues types.KeycloakSliceHashDelimited `json:"default.acr.values,omitempty"`
 }
 
 type OpenidAuthenticationFlowBindingOverrides struct {
@@ -113,6 +142,58 @@ func (keycloakClient *KeycloakClient) ValidateOpenidClient(ctx context.Context,
 		return fmt.Errorf("validation error: service accounts (client credentials flow) cannot be enabled on public clients")
 	}
 
+	if client.Attributes.CibaGrantEnabled {
+		if client.PublicClient {
+			return fmt.Errorf("validation error: the CIBA grant cannot be enabled on public clients")
+		}
+
+		if client.Attributes.CibaBackchannelTokenDeliveryMode == "ping" && client.Attributes.CibaBackchannelClientNotificationEndpoint == "" {
+			return fmt.Errorf("validation error: the CIBA ping delivery mode requires a client notification endpoint")
+		}
+
+		versionOk, err := keycloakClient.VersionIsGreaterThanOrEqualTo(ctx, Version_12)
+		if err != nil {
+			return err
+		}
+		if !versionOk {
+			return fmt.Errorf("validation error: the CIBA grant is only supported by Keycloak 12 and later")
+		}
+	}
+
+	if client.Attributes.AcrLoaMap != "" || len(client.Attributes.DefaultAcrValues) != 0 {
+		versionOk, err := keycloakClient.VersionIsGreaterThanOrEqualTo(ctx, Version_17)
+		if err != nil {
+			return err
+		}
+		if !versionOk {
+			return fmt.Errorf("validation error: ACR to LoA mappings are only supported by Keycloak 17 and later")
+		}
+
+		err = keycloakClient.validateOpenidClientDefaultAcrValues(ctx, client)
+		if err != nil {
+			return err
+		}
+	}
+
+	if client.ClientAuthenticatorType == "client-x509" && client.Attributes.X509SubjectDn == "" {
+		return fmt.Errorf("validation error: the client-x509 authenticator requires a subject DN")
+	}
+
+	err := validateOpenidClientTokenSecurity(client)
+	if err != nil {
+		return err
+	}
+
+	if client.Attributes.RequirePushedAuthorizationRequests {
+		versionOk, err := keycloakClient.VersionIsGreaterThanOrEqualTo(ctx, Version_13)
+		if err != nil {
+			return err
+		}
+		if !versionOk {
+			return fmt.Errorf("validation error: pushed authorization requests are only supported by Keycloak 13 and later")
+		}
+	}
+
 	serverInfo, err := keycloakClient.GetServerInfo(ctx)
 	if err != nil {
 		return err
@@ -197,6 +278,17 @@ func (keycloakClient *KeycloakClient) GetOpenidClient(ctx context.Context, realm
 	return &client, nil
 }
 
+func (keycloakClient *KeycloakClient) GetOpenidClientSecret(ctx context.Context, realmId, id string) (string, error) {
+	var clientSecret OpenidClientSecret
+
+	err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/clients/%s/client-secret", realmId, id), &clientSecret, nil)
+	if err != nil {
+		return "", err
+	}
+
+	return clientSecret.Value, nil
+}
+
 func (keycloakClient *KeycloakClient) GetOpenidClientByClientId(ctx context.Context, realmId, clientId string) (*OpenidClient, error) {
 	var clients []OpenidClient
 	var clientSecret OpenidClientSecret
@@ -0,0 +1,28 @@
+package keycloak
+
+import (
+	"context"
+	"fmt"
+)
+
+const (
+	OpenidClientJwtCertificateFormatPem  = "Certificate PEM"
+	OpenidClientJwtCertificateFormatJwks = "JSON Web Key Set"
+)
+
+// UploadOpenidClientJwtCertificate uploads the certificate or JWKS that Keycloak uses to verify the signed JWTs of a client
+// that authenticates with the client-jwt authenticator
+func (keycloakClient *KeycloakClient) UploadOpenidClientJwtCertificate(ctx context.Context, realmId, clientId, keystoreFormat, content string) error {
+	fileName := "certificate.pem"
+	if keystoreFormat == OpenidClientJwtCertificateFormatJwks {
+		fileName = "jwks.json"
+	}
+
+	fields := map[string]string{
+		"keystoreFormat": keystoreFormat,
+	}
+
+	_, err := keycloakClient.postMultipart(ctx, fmt.Sprintf("/realms/%s/clients/%s/certificates/jwt.credential/upload-certificate", realmId, clientId), fields, fileName, []byte(content))
+
+	return err
+}
@@ -0,0 +1,106 @@
+package keycloak
+
+import (
+	"encoding/json"
+	"encoding/xml"
+)
+
+const (
+	OpenidClientInstallationProviderKeycloakJson   = "keycloak-oidc-keycloak-json"
+	OpenidClientInstallationProviderJbossSubsystem = "keycloak-oidc-jboss-subsystem"
+)
+
+// OpenidClientInstallation holds the fields of an OIDC adapter configuration that deployments usually need
+type OpenidClientInstallation struct {
+	Realm         string
+	AuthServerUrl string
+	Resource      string
+	SslRequired   string
+	PublicClient  bool
+	BearerOnly    bool
+	Credentials   map[string]string
+}
+
+type openidClientInstallationJson struct {
+	Realm         string                 `json:"realm"`
+	AuthServerUrl string                 `json:"auth-server-url"`
+	Resource      string                 `json:"resource"`
+	SslRequired   string                 `json:"ssl-required"`
+	PublicClient  bool                   `json:"public-client"`
+	BearerOnly    bool                   `json:"bearer-only"`
+	Credentials   map[string]interface{} `json:"credentials"`
+}
+
+type openidClientInstallationXml struct {
+	Realm         string `xml:"realm"`
+	AuthServerUrl string `xml:"auth-server-url"`
+	Resource      string `xml:"resource"`
+	SslRequired   string `xml:"ssl-required"`
+	PublicClient  bool   `xml:"public-client"`
+	BearerOnly    bool   `xml:"bearer-only"`
+	Credentials   []struct {
+		Name  string `xml:"name,attr"`
+		Value string `xml:",chardata"`
+	} `xml:"credential"`
+}
+
+// ParseOpenidClientInstallation extracts the adapter configuration from the document of an installation provider. Only the
+// keycloak.json and JBoss subsystem formats can be parsed, nil is returned for the other providers.
+func ParseOpenidClientInstallation(providerId string, value []byte) (*OpenidClientInstallation, error) {
+	switch providerId {
+	case OpenidClientInstallationProviderKeycloakJson:
+		var installation openidClientInstallationJson
+		err := json.Unmarshal(value, &installation)
+		if err != nil {
+			return nil, err
+		}
+
+		// credentials are usually strings, but some authenticators use nested objects, which are kept as json
+		credentials := map[string]string{}
+		for name, credential := range installation.Credentials {
+			if s, ok := credential.(string); ok {
+				credentials[name] = s
+				continue
+			}
+
+			b, err := json.Marshal(credential)
+			if err != nil {
+				return nil, err
+			}
+			credentials[name] = string(b)
+		}
+
+		return &OpenidClientInstallation{
+			Realm:         installation.Realm,
+			AuthServerUrl: installation.AuthServerUrl,
+			Resource:      installation.Resource,
+			SslRequired:   installation.SslRequired,
+			PublicClient:  installation.PublicClient,
+			BearerOnly:    installation.BearerOnly,
+			Credentials:   credentials,
+		}, nil
+	case OpenidClientInstallationProviderJbossSubsystem:
+		var installation openidClientInstallationXml
+		err := xml.Unmarshal(value, &installation)
+		if err != nil {
+			return nil, err
+		}
+
+		credentials := map[string]string{}
+		for _, credential := range installation.Credentials {
+			credentials[credential.Name] = credential.Value
+		}
+
+		return &OpenidClientInstallation{
+			Realm:         installation.Realm,
+			AuthServerUrl: installation.AuthServerUrl,
+			Resource:      installation.Resource,
+			SslRequired:   installation.SslRequired,
+			PublicClient:  installation.PublicClient,
+			BearerOnly:    installation.BearerOnly,
+			Credentials:   credentials,
+		}, nil
+	}
+
+	return nil, nil
+}
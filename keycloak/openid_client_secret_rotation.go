@@ -0,0 +1,81 @@
+package keycloak
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+)
+
+// OpenidClientSecretRotation describes the current secret of a confidential client, along with the rotated secret that Keycloak
+// keeps valid for a grace period when the realm has a client policy with the secret-rotation executor.
+// Times are unix timestamps in seconds, and are zero when Keycloak did not record them.
+type OpenidClientSecretRotation struct {
+	RealmId                           string
+	ClientId                          string
+	ClientSecret                      string
+	ClientSecretCreationTime          int64
+	RotatedClientSecret               string
+	RotatedClientSecretCreationTime   int64
+	RotatedClientSecretExpirationTime int64
+}
+
+// the rotation timestamps are only exposed as client attributes
+type openidClientSecretAttributes struct {
+	Attributes map[string]string `json:"attributes"`
+}
+
+func openidClientSecretUrl(realmId, clientId string) string {
+	return fmt.Sprintf("/realms/%s/clients/%s/client-secret", realmId, clientId)
+}
+
+func parseClientSecretTime(attributes map[string]string, key string) int64 {
+	value, err := strconv.ParseInt(attributes[key], 10, 64)
+	if err != nil {
+		return 0
+	}
+
+	return value
+}
+
+func (keycloakClient *KeycloakClient) GetOpenidClientSecretRotation(ctx context.Context, realmId, clientId string) (*OpenidClientSecretRotation, error) {
+	var client openidClientSecretAttributes
+	err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/clients/%s", realmId, clientId), &client, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var clientSecret OpenidClientSecret
+	err = keycloakClient.get(ctx, openidClientSecretUrl(realmId, clientId), &clientSecret, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// keycloak responds with a 404 when the client does not have a rotated secret
+	var rotatedClientSecret OpenidClientSecret
+	err = keycloakClient.get(ctx, openidClientSecretUrl(realmId, clientId)+"/rotated", &rotatedClientSecret, nil)
+	if err != nil && !ErrorIs404(err) {
+		return nil, err
+	}
+
+	return &OpenidClientSecretRotation{
+		RealmId:                           realmId,
+		ClientId:                          clientId,
+		ClientSecret:                      clientSecret.Value,
+		ClientSecretCreationTime:          parseClientSecretTime(client.Attributes, "client.secret.creation.time"),
+		RotatedClientSecret:               rotatedClientSecret.Value,
+		RotatedClientSecretCreationTime:   parseClientSecretTime(client.Attributes, "client.secret.rotated.creation.time"),
+		RotatedClientSecretExpirationTime: parseClientSecretTime(client.Attributes, "client.secret.rotated.expiration.time"),
+	}, nil
+}
+
+// RegenerateOpenidClientSecret replaces the secret of a client. With a secret-rotation client policy, the previous secret becomes
+// the rotated secret instead of being discarded.
+func (keycloakClient *KeycloakClient) RegenerateOpenidClientSecret(ctx context.Context, realmId, clientId string) error {
+	_, _, err := keycloakClient.post(ctx, openidClientSecretUrl(realmId, clientId), nil)
+
+	return err
+}
+
+func (keycloakClient *KeycloakClient) InvalidateOpenidClientRotatedSecret(ctx context.Context, realmId, clientId string) error {
+	return keycloakClient.delete(ctx, openidClientSecretUrl(realmId, clientId)+"/rotated", nil)
+}
@@ -0,0 +1,78 @@
+package keycloak
+
+import (
+	"context"
+	"fmt"
+)
+
+// validateOpenidClientTokenSecurity checks the combination of token security attributes of the client. The signing
+// algorithms depend on the keys of the realm, so they are checked during plan by ValidateOpenidClientSigningAlgorithms.
+func validateOpenidClientTokenSecurity(client *OpenidClient) error {
+	if client.Attributes.IdTokenEncryptedResponseEnc != "" && client.Attributes.IdTokenEncryptedResponseAlg == "" {
+		return fmt.Errorf("validation error: an ID token encryption method requires an ID token encryption algorithm")
+	}
+
+	return nil
+}
+
+// ValidateOpenidClientSigningAlgorithms checks that the realm has an active key for every algorithm Keycloak is asked to sign
+// the tokens and responses of a client with. The algorithms of request objects and ID token encryption depend on the keys of
+// the client instead, so they are not checked here.
+func (keycloakClient *KeycloakClient) ValidateOpenidClientSigningAlgorithms(ctx context.Context, realmId string, attributes *OpenidClientAttributes) error {
+	signingAlgorithms := []struct {
+		name      string
+		algorithm string
+	}{
+		{"access token", attributes.AccessTokenSignedResponseAlg},
+		{"ID token", attributes.IdTokenSignedResponseAlg},
+		{"user info response", attributes.UserInfoResponseSignatureAlg},
+		{"authorization response", attributes.AuthorizationSignedResponseAlg},
+	}
+
+	var activeAlgorithms map[string]bool
+	for _, signingAlgorithm := range signingAlgorithms {
+		name, algorithm := signingAlgorithm.name, signingAlgorithm.algorithm
+
+		// an empty algorithm falls back to the default of the realm, and user info responses can be left unsigned
+		if algorithm == "" || algorithm == "unsigned" {
+			continue
+		}
+
+		if activeAlgorithms == nil {
+			var err error
+			activeAlgorithms, err = keycloakClient.getRealmActiveSigningAlgorithms(ctx, realmId)
+			if err != nil {
+				return err
+			}
+		}
+
+		if !activeAlgorithms[algorithm] {
+			return fmt.Errorf("validation error: the %s signing algorithm %s does not have an active key in realm %s", name, algorithm, realmId)
+		}
+	}
+
+	return nil
+}
+
+func (keycloakClient *KeycloakClient) getRealmActiveSigningAlgorithms(ctx context.Context, realmId string) (map[string]bool, error) {
+	keys, err := keycloakClient.GetRealmKeys(ctx, realmId)
+	if err != nil {
+		return nil, err
+	}
+
+	algorithms := map[string]bool{}
+	for _, key := range keys.Keys {
+		if key.Algorithm == nil || key.Status == nil || *key.Status != "ACTIVE" {
+			continue
+		}
+
+		// keys are only marked with their use by Keycloak 12 and later
+		if key.Use != nil && *key.Use != "SIG" {
+			continue
+		}
+
+		algorithms[*key.Algorithm] = true
+	}
+
+	return algorithms, nil
+}
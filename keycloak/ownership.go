@@ -0,0 +1,23 @@
+package keycloak
+
+import (
+	"fmt"
+)
+
+// OwnershipTagAttribute is the attribute used to mark realms, clients and groups as created by a specific Terraform
+// workspace, see the provider's ownership_tag argument.
+const OwnershipTagAttribute = "terraform.ownership-tag"
+
+func (keycloakClient *KeycloakClient) OwnershipTag() string {
+	return keycloakClient.ownershipTag
+}
+
+// ValidateOwnership returns an error when an object is marked as owned by a different ownership tag than the one
+// configured for this client. Objects without an owner can always be managed, as can everything when no ownership tag is configured.
+func (keycloakClient *KeycloakClient) ValidateOwnership(description, owner string) error {
+	if keycloakClient.ownershipTag == "" || owner == "" || owner == keycloakClient.ownershipTag {
+		return nil
+	}
+
+	return fmt.Errorf("validation error: %s is owned by another Terraform workspace (ownership tag \"%s\"), refusing to manage it", description, owner)
+}
@@ -0,0 +1,62 @@
+package keycloak
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+const (
+	PartialImportIfResourceExistsFail      = "FAIL"
+	PartialImportIfResourceExistsSkip      = "SKIP"
+	PartialImportIfResourceExistsOverwrite = "OVERWRITE"
+)
+
+// PartialImportUser is the subset of a user representation that the partial import endpoint accepts along with its memberships
+type PartialImportUser struct {
+	Username        string              `json:"username"`
+	Email           string              `json:"email,omitempty"`
+	EmailVerified   bool                `json:"emailVerified"`
+	FirstName       string              `json:"firstName,omitempty"`
+	LastName        string              `json:"lastName,omitempty"`
+	Enabled         bool                `json:"enabled"`
+	Attributes      map[string][]string `json:"attributes,omitempty"`
+	RequiredActions []string            `json:"requiredActions,omitempty"`
+	Groups          []string            `json:"groups,omitempty"`
+	RealmRoles      []string            `json:"realmRoles,omitempty"`
+	ClientRoles     map[string][]string `json:"clientRoles,omitempty"`
+}
+
+type PartialImport struct {
+	IfResourceExists string               `json:"ifResourceExists"`
+	Users            []*PartialImportUser `json:"users,omitempty"`
+}
+
+type PartialImportResult struct {
+	Action       string `json:"action"`
+	ResourceType string `json:"resourceType"`
+	ResourceName string `json:"resourceName"`
+	Id           string `json:"id"`
+}
+
+type PartialImportResults struct {
+	Added       int                    `json:"added"`
+	Skipped     int                    `json:"skipped"`
+	Overwritten int                    `json:"overwritten"`
+	Results     []*PartialImportResult `json:"results"`
+}
+
+func (keycloakClient *KeycloakClient) PartialImport(ctx context.Context, realmId string, partialImport *PartialImport) (*PartialImportResults, error) {
+	body, _, err := keycloakClient.post(ctx, fmt.Sprintf("/realms/%s/partialImport", realmId), partialImport)
+	if err != nil {
+		return nil, err
+	}
+
+	var results PartialImportResults
+	err = json.Unmarshal(body, &results)
+	if err != nil {
+		return nil, err
+	}
+
+	return &results, nil
+}
@@ -2,6 +2,7 @@ package keycloak
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"github.com/mrparkers/terraform-provider-keycloak/keycloak/types"
 	"strings"
@@ -16,12 +17,22 @@ type Key struct {
 	Kid              *string `json:"kid,omitempty"`
 	Status           *string `json:"status,omitempty"`
 	Type             *string `json:"type,omitempty"`
+	Use              *string `json:"use,omitempty"`
 }
 
 type Keys struct {
 	Keys []Key `json:"keys"`
 }
 
+// CIBA and PAR policies are not part of the realm representation, Keycloak stores them as realm attributes instead.
+const (
+	RealmAttributeCibaBackchannelTokenDeliveryMode = "cibaBackchannelTokenDeliveryMode"
+	RealmAttributeCibaExpiresIn                    = "cibaExpiresIn"
+	RealmAttributeCibaInterval                     = "cibaInterval"
+	RealmAttributeCibaAuthRequestedUserHint        = "cibaAuthRequestedUserHint"
+	RealmAttributeParRequestUriLifespan            = "parRequestUriLifespan"
+)
+
 type Realm struct {
 	Id                string `json:"id,omitempty"`
 	Realm             string `json:"realm"`
@@ -30,6 +41,9 @@ type Realm struct {
 	DisplayNameHtml   string `json:"displayNameHtml"`
 	UserManagedAccess bool   `json:"userManagedAccessAllowed"`
 
+	// Fine-grained admin permissions v2
+	AdminPermissionsEnabled *bool `json:"adminPermissionsEnabled,omitempty"`
+
 	// Login Config
 	RegistrationAllowed         bool   `json:"registrationAllowed"`
 	RegistrationEmailAsUsername bool   `json:"registrationEmailAsUsername"`
@@ -206,8 +220,101 @@ func (keycloakClient *KeycloakClient) GetRealmKeys(ctx context.Context, name str
 	return &keys, nil
 }
 
+// UpdateRealm does a read-modify-write of the realm. Keycloak resets every field that is missing from the PUT body, and
+// replaces the attributes of the realm wholesale, so fields and attributes this provider doesn't model are read from
+// the server first and sent back unchanged.
 func (keycloakClient *KeycloakClient) UpdateRealm(ctx context.Context, realm *Realm) error {
-	return keycloakClient.put(ctx, fmt.Sprintf("/realms/%s", realm.Realm), realm)
+	return keycloakClient.UpdateRealmRemovingAttributes(ctx, realm, nil)
+}
+
+// UpdateRealmRemovingAttributes works like UpdateRealm, but also removes the given attributes from the realm.
+func (keycloakClient *KeycloakClient) UpdateRealmRemovingAttributes(ctx context.Context, realm *Realm, removedAttributes []string) error {
+	existingRealm, err := keycloakClient.getRealmRepresentation(ctx, realm.Realm)
+	if err != nil {
+		return err
+	}
+
+	body, err := json.Marshal(realm)
+	if err != nil {
+		return err
+	}
+
+	var updatedRealm map[string]interface{}
+	err = json.Unmarshal(body, &updatedRealm)
+	if err != nil {
+		return err
+	}
+
+	attributes := getRealmRepresentationAttributes(existingRealm)
+	for key, value := range realm.Attributes {
+		attributes[key] = value
+	}
+	for _, key := range removedAttributes {
+		delete(attributes, key)
+	}
+
+	for key, value := range updatedRealm {
+		existingRealm[key] = value
+	}
+	existingRealm["attributes"] = attributes
+
+	return keycloakClient.put(ctx, fmt.Sprintf("/realms/%s", realm.Realm), existingRealm)
+}
+
+// GetRealmAttributes returns all attributes of the realm, including the ones Keycloak mirrors from top level fields.
+func (keycloakClient *KeycloakClient) GetRealmAttributes(ctx context.Context, realmName string) (map[string]string, error) {
+	existingRealm, err := keycloakClient.getRealmRepresentation(ctx, realmName)
+	if err != nil {
+		return nil, err
+	}
+
+	attributes := map[string]string{}
+	for key, value := range getRealmRepresentationAttributes(existingRealm) {
+		if stringValue, ok := value.(string); ok {
+			attributes[key] = stringValue
+		}
+	}
+
+	return attributes, nil
+}
+
+// UpdateRealmAttributes sets and removes the given attributes, without touching any other part of the realm.
+func (keycloakClient *KeycloakClient) UpdateRealmAttributes(ctx context.Context, realmName string, attributes map[string]string, removedAttributes []string) error {
+	existingRealm, err := keycloakClient.getRealmRepresentation(ctx, realmName)
+	if err != nil {
+		return err
+	}
+
+	existingAttributes := getRealmRepresentationAttributes(existingRealm)
+	for _, key := range removedAttributes {
+		delete(existingAttributes, key)
+	}
+	for key, value := range attributes {
+		existingAttributes[key] = value
+	}
+	existingRealm["attributes"] = existingAttributes
+
+	return keycloakClient.put(ctx, fmt.Sprintf("/realms/%s", realmName), existingRealm)
+}
+
+// getRealmRepresentation returns the realm exactly as Keycloak sends it, including fields that are not part of Realm.
+func (keycloakClient *KeycloakClient) getRealmRepresentation(ctx context.Context, realmName string) (map[string]interface{}, error) {
+	var realm map[string]interface{}
+
+	err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s", realmName), &realm, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return realm, nil
+}
+
+func getRealmRepresentationAttributes(realm map[string]interface{}) map[string]interface{} {
+	if attributes, ok := realm["attributes"].(map[string]interface{}); ok {
+		return attributes
+	}
+
+	return map[string]interface{}{}
 }
 
 func (keycloakClient *KeycloakClient) DeleteRealm(ctx context.Context, name string) error {
@@ -233,6 +340,46 @@ func (keycloakClient *KeycloakClient) ValidateRealm(ctx context.Context, realm *
 		return fmt.Errorf("validation error: SslRequired should be 'none', 'external' or 'all'")
 	}
 
+	if _, ok := realm.Attributes[RealmAttributeCibaBackchannelTokenDeliveryMode]; ok {
+		versionOk, err := keycloakClient.VersionIsGreaterThanOrEqualTo(ctx, Version_12)
+		if err != nil {
+			return err
+		}
+		if !versionOk {
+			return fmt.Errorf("validation error: CIBA policies are only supported by Keycloak 12 and later")
+		}
+	}
+
+	if acrLoaMap, ok := realm.Attributes[AcrLoaMapAttribute].(string); ok && acrLoaMap != "" {
+		versionOk, err := keycloakClient.VersionIsGreaterThanOrEqualTo(ctx, Version_17)
+		if err != nil {
+			return err
+		}
+		if !versionOk {
+			return fmt.Errorf("validation error: ACR to LoA mappings are only supported by Keycloak 17 and later")
+		}
+	}
+
+	if _, ok := realm.Attributes[RealmAttributeParRequestUriLifespan]; ok {
+		versionOk, err := keycloakClient.VersionIsGreaterThanOrEqualTo(ctx, Version_13)
+		if err != nil {
+			return err
+		}
+		if !versionOk {
+			return fmt.Errorf("validation error: PAR policies are only supported by Keycloak 13 and later")
+		}
+	}
+
+	if realm.AdminPermissionsEnabled != nil && *realm.AdminPermissionsEnabled {
+		versionOk, err := keycloakClient.VersionIsGreaterThanOrEqualTo(ctx, Version_26_2)
+		if err != nil {
+			return err
+		}
+		if !versionOk {
+			return fmt.Errorf("validation error: admin permissions v2 are only supported by Keycloak 26.2 and later")
+		}
+	}
+
 	// validate if the given theme exists on the server. the keycloak API allows you to use any random string for a theme
 	serverInfo, err := keycloakClient.GetServerInfo(ctx)
 	if err != nil {
@@ -0,0 +1,63 @@
+package keycloak
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/hashicorp/errwrap"
+)
+
+// realmSmtpServer is a partial realm representation. Keycloak ignores any realm fields that are omitted from an update,
+// so only the SMTP settings are changed when this is sent to the API.
+type realmSmtpServer struct {
+	Realm      string     `json:"realm"`
+	SmtpServer SmtpServer `json:"smtpServer"`
+}
+
+type smtpConnectionTestError struct {
+	Error        string `json:"error"`
+	ErrorMessage string `json:"errorMessage"`
+}
+
+func (keycloakClient *KeycloakClient) GetRealmSmtpServer(ctx context.Context, realmName string) (*SmtpServer, error) {
+	realm, err := keycloakClient.GetRealm(ctx, realmName)
+	if err != nil {
+		return nil, err
+	}
+
+	return &realm.SmtpServer, nil
+}
+
+func (keycloakClient *KeycloakClient) UpdateRealmSmtpServer(ctx context.Context, realmName string, smtpServer *SmtpServer) error {
+	return keycloakClient.put(ctx, fmt.Sprintf("/realms/%s", realmName), &realmSmtpServer{
+		Realm:      realmName,
+		SmtpServer: *smtpServer,
+	})
+}
+
+// TestRealmSmtpConnection asks Keycloak to send a test email using the given settings. Keycloak sends this email to the
+// address of the user the provider is authenticated as, so that user must have an email address.
+func (keycloakClient *KeycloakClient) TestRealmSmtpConnection(ctx context.Context, realmName string, smtpServer *SmtpServer) error {
+	_, _, err := keycloakClient.post(ctx, fmt.Sprintf("/realms/%s/testSMTPConnection", realmName), smtpServer)
+	if err == nil {
+		return nil
+	}
+
+	apiError, ok := errwrap.GetType(err, &ApiError{}).(*ApiError)
+	if !ok || apiError == nil {
+		return err
+	}
+
+	var testError smtpConnectionTestError
+	if jsonErr := json.Unmarshal(apiError.Body, &testError); jsonErr == nil {
+		if testError.ErrorMessage != "" {
+			return fmt.Errorf("smtp connection test failed: %s", testError.ErrorMessage)
+		}
+
+		if testError.Error != "" {
+			return fmt.Errorf("smtp connection test failed: %s", testError.Error)
+		}
+	}
+
+	return fmt.Errorf("smtp connection test failed: %s", apiError.Message)
+}
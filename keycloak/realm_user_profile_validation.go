@@ -0,0 +1,166 @@
+package keycloak
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// these attributes are managed through the top level fields of a user rather than its attributes
+var userProfileBuiltInAttributes = []string{"username", "email", "firstName", "lastName"}
+
+// ValidateUserAttributes checks the attributes of a user against the user profile of its realm, as seen by an administrator.
+// Realms without a user profile, or that do not exist yet, accept any attribute.
+func (keycloakClient *KeycloakClient) ValidateUserAttributes(ctx context.Context, realmId string, attributes map[string][]string) error {
+	versionOk, err := keycloakClient.VersionIsGreaterThanOrEqualTo(ctx, Version_15)
+	if err != nil {
+		return err
+	}
+	if !versionOk {
+		return nil
+	}
+
+	body, err := keycloakClient.getRaw(ctx, fmt.Sprintf("/realms/%s/users/profile", realmId), nil)
+	if err != nil {
+		// the realm may not exist yet when it is created in the same run
+		if ErrorIs404(err) {
+			return nil
+		}
+		return err
+	}
+	if string(body) == "" {
+		return nil
+	}
+
+	// the validator configuration is decoded as is, GetRealmUserProfile flattens lists into strings
+	var realmUserProfile RealmUserProfile
+	err = json.Unmarshal(body, &realmUserProfile)
+	if err != nil {
+		return err
+	}
+
+	return realmUserProfile.validateUserAttributes(attributes)
+}
+
+func (realmUserProfile *RealmUserProfile) validateUserAttributes(attributes map[string][]string) error {
+	for _, attribute := range realmUserProfile.Attributes {
+		if contains(userProfileBuiltInAttributes, attribute.Name) {
+			continue
+		}
+
+		values, ok := attributes[attribute.Name]
+
+		if !ok || len(values) == 0 {
+			if attribute.isRequiredForAdmin() {
+				return fmt.Errorf("validation error: attribute %s is required by the user profile of this realm", attribute.Name)
+			}
+
+			continue
+		}
+
+		if attribute.Permissions != nil && !contains(attribute.Permissions.Edit, "admin") {
+			return fmt.Errorf("validation error: attribute %s cannot be edited by an administrator according to the user profile of this realm", attribute.Name)
+		}
+
+		for validatorName, config := range attribute.Validations {
+			for _, value := range values {
+				if err := validateUserProfileAttributeValue(validatorName, config, value); err != nil {
+					return fmt.Errorf("validation error: attribute %s: %s", attribute.Name, err)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+func (attribute *RealmUserProfileAttribute) isRequiredForAdmin() bool {
+	if attribute.Required == nil {
+		return false
+	}
+
+	// attributes that are only required when a scope is requested do not apply to the admin API
+	if len(attribute.Required.Scopes) != 0 {
+		return false
+	}
+
+	return len(attribute.Required.Roles) == 0 || contains(attribute.Required.Roles, "admin")
+}
+
+// validateUserProfileAttributeValue implements the built-in validators of Keycloak that can be checked without a server.
+// Unknown validators are left for Keycloak to enforce.
+func validateUserProfileAttributeValue(validatorName string, config RealmUserProfileValidationConfig, value string) error {
+	switch validatorName {
+	case "length":
+		if min, ok := userProfileValidationConfigNumber(config, "min"); ok && float64(utf8.RuneCountInString(value)) < min {
+			return fmt.Errorf("value %s must be at least %v characters long", value, min)
+		}
+		if max, ok := userProfileValidationConfigNumber(config, "max"); ok && float64(utf8.RuneCountInString(value)) > max {
+			return fmt.Errorf("value %s must be at most %v characters long", value, max)
+		}
+	case "integer", "double":
+		number, err := strconv.ParseFloat(value, 64)
+		if err != nil || (validatorName == "integer" && number != float64(int64(number))) {
+			return fmt.Errorf("value %s must be of type %s", value, validatorName)
+		}
+		if min, ok := userProfileValidationConfigNumber(config, "min"); ok && number < min {
+			return fmt.Errorf("value %s must be at least %v", value, min)
+		}
+		if max, ok := userProfileValidationConfigNumber(config, "max"); ok && number > max {
+			return fmt.Errorf("value %s must be at most %v", value, max)
+		}
+	case "pattern":
+		pattern, ok := config["pattern"].(string)
+		if !ok {
+			return nil
+		}
+		// Keycloak uses java regular expressions, patterns that go cannot compile are left for Keycloak to enforce
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil
+		}
+		if !re.MatchString(value) {
+			return fmt.Errorf("value %s does not match the pattern %s", value, pattern)
+		}
+	case "options":
+		options, ok := config["options"].([]interface{})
+		if !ok {
+			return nil
+		}
+		for _, option := range options {
+			if fmt.Sprint(option) == value {
+				return nil
+			}
+		}
+		return fmt.Errorf("value %s is not one of the allowed options %v", value, options)
+	case "email":
+		if _, err := mail.ParseAddress(value); err != nil {
+			return fmt.Errorf("value %s is not a valid email address", value)
+		}
+	case "uri":
+		if _, err := url.ParseRequestURI(value); err != nil {
+			return fmt.Errorf("value %s is not a valid uri", value)
+		}
+	}
+
+	return nil
+}
+
+// validator configuration is stored as numbers or strings depending on how the user profile was written
+func userProfileValidationConfigNumber(config RealmUserProfileValidationConfig, key string) (float64, bool) {
+	switch v := config[key].(type) {
+	case float64:
+		return v, true
+	case string:
+		number, err := strconv.ParseFloat(strings.TrimSpace(v), 64)
+		return number, err == nil
+	}
+
+	return 0, false
+}
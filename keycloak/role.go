@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"net/url"
+	"strconv"
 )
 
 type Role struct {
@@ -80,6 +81,44 @@ func (keycloakClient *KeycloakClient) GetRealmRoles(ctx context.Context, realmId
 	return roles, nil
 }
 
+// SearchRoles pages through the realm roles, or the roles of a client when clientId is set, whose name contains search
+func (keycloakClient *KeycloakClient) SearchRoles(ctx context.Context, realmId, clientId, search string) ([]*Role, error) {
+	var roles []*Role
+	var first, pagination int = 0, 100
+
+	for {
+		var iterationRoles []*Role
+
+		params := map[string]string{
+			"first":               strconv.Itoa(first),
+			"max":                 strconv.Itoa(pagination),
+			"briefRepresentation": "false",
+		}
+		if search != "" {
+			params["search"] = search
+		}
+
+		err := keycloakClient.get(ctx, roleByNameUrl(realmId, clientId), &iterationRoles, params)
+		if err != nil {
+			return nil, err
+		}
+
+		roles = append(roles, iterationRoles...)
+
+		if len(iterationRoles) < pagination {
+			break
+		}
+		first += pagination
+	}
+
+	for _, role := range roles {
+		role.RealmId = realmId
+		role.ClientId = clientId
+	}
+
+	return roles, nil
+}
+
 func (keycloakClient *KeycloakClient) GetClientRoles(ctx context.Context, realmId string, clients []*OpenidClient) ([]*Role, error) {
 	var roles []*Role
 
@@ -29,6 +29,15 @@ type SamlClientAttributes struct {
 	LogoutServicePostBindingURL     string                   `json:"saml_single_logout_service_url_post"`
 	LogoutServiceRedirectBindingURL string                   `json:"saml_single_logout_service_url_redirect"`
 	LoginTheme                      string                   `json:"login_theme"`
+	ArtifactBinding                 types.KeycloakBoolQuoted `json:"saml.artifact.binding"`
+	ArtifactBindingURL              string                   `json:"saml_artifact_binding_url"`
+	ArtifactResolutionServiceURL    string                   `json:"saml_artifact_resolution_service_url"`
+	LogoutServiceArtifactBindingURL string                   `json:"saml_single_logout_service_url_artifact"`
+	LogoutServiceSoapBindingURL     string                   `json:"saml_single_logout_service_url_soap"`
+	AllowEcpFlow                    types.KeycloakBoolQuoted `json:"saml.allow.ecp.flow"`
+	EncryptionAlgorithm             string                   `json:"saml.encryption.algorithm"`
+	EncryptionKeyAlgorithm          string                   `json:"saml.encryption.keyAlgorithm"`
+	EncryptionDigestMethod          string                   `json:"saml.encryption.digestMethod"`
 
 	ExtraConfig map[string]interface{} `json:"-"`
 }
@@ -63,6 +72,56 @@ type SamlClient struct {
 	AuthenticationFlowBindingOverrides SamlAuthenticationFlowBindingOverrides `json:"authenticationFlowBindingOverrides,omitempty"`
 }
 
+func (keycloakClient *KeycloakClient) ValidateSamlClient(ctx context.Context, client *SamlClient) error {
+	attributes := client.Attributes
+
+	if attributes.ArtifactBinding || attributes.ArtifactBindingURL != "" || attributes.ArtifactResolutionServiceURL != "" || attributes.LogoutServiceArtifactBindingURL != "" {
+		versionOk, err := keycloakClient.VersionIsGreaterThanOrEqualTo(ctx, Version_12)
+		if err != nil {
+			return err
+		}
+		if !versionOk {
+			return fmt.Errorf("validation error: the SAML artifact binding is only supported by Keycloak 12 and later")
+		}
+	}
+
+	if attributes.AllowEcpFlow {
+		versionOk, err := keycloakClient.VersionIsGreaterThanOrEqualTo(ctx, Version_15)
+		if err != nil {
+			return err
+		}
+		if !versionOk {
+			return fmt.Errorf("validation error: the SAML ECP flow setting is only supported by Keycloak 15 and later")
+		}
+	}
+
+	if attributes.LogoutServiceSoapBindingURL != "" {
+		versionOk, err := keycloakClient.VersionIsGreaterThanOrEqualTo(ctx, Version_20)
+		if err != nil {
+			return err
+		}
+		if !versionOk {
+			return fmt.Errorf("validation error: SAML SOAP logout is only supported by Keycloak 20 and later")
+		}
+	}
+
+	if attributes.EncryptionAlgorithm != "" || attributes.EncryptionKeyAlgorithm != "" || attributes.EncryptionDigestMethod != "" {
+		versionOk, err := keycloakClient.VersionIsGreaterThanOrEqualTo(ctx, Version_21)
+		if err != nil {
+			return err
+		}
+		if !versionOk {
+			return fmt.Errorf("validation error: SAML encryption algorithms are only supported by Keycloak 21 and later")
+		}
+	}
+
+	if attributes.EncryptionDigestMethod != "" && attributes.EncryptionKeyAlgorithm == "http://www.w3.org/2001/04/xmlenc#rsa-1_5" {
+		return fmt.Errorf("validation error: a digest method cannot be used with the RSA 1.5 key algorithm")
+	}
+
+	return nil
+}
+
 func (keycloakClient *KeycloakClient) NewSamlClient(ctx context.Context, client *SamlClient) error {
 	client.Protocol = "saml"
 	client.ClientAuthenticatorType = "client-secret"
@@ -91,8 +150,7 @@ func (keycloakClient *KeycloakClient) GetSamlClient(ctx context.Context, realmId
 }
 
 func (keycloakClient *KeycloakClient) GetSamlClientInstallationProvider(ctx context.Context, realmId, id string, providerId string) ([]byte, error) {
-	value, err := keycloakClient.getRaw(ctx, fmt.Sprintf("/realms/%s/clients/%s/installation/providers/%s", realmId, id, providerId), nil)
-	return value, err
+	return keycloakClient.GetClientInstallationProvider(ctx, realmId, id, providerId)
 }
 
 func (keycloakClient *KeycloakClient) GetSamlClientByClientId(ctx context.Context, realmId, clientId string) (*SamlClient, error) {
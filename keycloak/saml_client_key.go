@@ -0,0 +1,96 @@
+package keycloak
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+const (
+	SamlClientKeyTypeSigning    = "signing"
+	SamlClientKeyTypeEncryption = "encryption"
+)
+
+// https://www.keycloak.org/docs-api/20.0.0/rest-api/index.html#_certificaterepresentation
+type samlClientCertificate struct {
+	PrivateKey  string `json:"privateKey,omitempty"`
+	PublicKey   string `json:"publicKey,omitempty"`
+	Certificate string `json:"certificate,omitempty"`
+	Kid         string `json:"kid,omitempty"`
+}
+
+// SamlClientKey is the key pair a SAML client uses to sign or encrypt documents, the certificate and private key are base64
+// encoded without PEM headers, the same way Keycloak stores them in the client attributes
+type SamlClientKey struct {
+	RealmId     string
+	ClientId    string
+	KeyType     string
+	Certificate string
+	PrivateKey  string
+	NotBefore   time.Time
+	NotAfter    time.Time
+}
+
+func samlClientKeyUrl(realmId, clientId, keyType string) string {
+	return fmt.Sprintf("/realms/%s/clients/%s/certificates/saml.%s", realmId, clientId, keyType)
+}
+
+func newSamlClientKey(realmId, clientId, keyType string, certificate *samlClientCertificate) (*SamlClientKey, error) {
+	key := &SamlClientKey{
+		RealmId:     realmId,
+		ClientId:    clientId,
+		KeyType:     keyType,
+		Certificate: certificate.Certificate,
+		PrivateKey:  certificate.PrivateKey,
+	}
+
+	if certificate.Certificate == "" {
+		return key, nil
+	}
+
+	der, err := base64.StdEncoding.DecodeString(certificate.Certificate)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding saml %s certificate: %v", keyType, err)
+	}
+
+	x509Certificate, err := x509.ParseCertificate(der)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing saml %s certificate: %v", keyType, err)
+	}
+
+	key.NotBefore = x509Certificate.NotBefore
+	key.NotAfter = x509Certificate.NotAfter
+
+	return key, nil
+}
+
+// GetSamlClientKey returns the signing or encryption key of a SAML client, the certificate is empty when the client has no such key
+func (keycloakClient *KeycloakClient) GetSamlClientKey(ctx context.Context, realmId, clientId, keyType string) (*SamlClientKey, error) {
+	var certificate samlClientCertificate
+
+	err := keycloakClient.get(ctx, samlClientKeyUrl(realmId, clientId, keyType), &certificate, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return newSamlClientKey(realmId, clientId, keyType, &certificate)
+}
+
+// GenerateSamlClientKey replaces the signing or encryption key of a SAML client with a new key pair and self-signed certificate
+func (keycloakClient *KeycloakClient) GenerateSamlClientKey(ctx context.Context, realmId, clientId, keyType string) (*SamlClientKey, error) {
+	body, _, err := keycloakClient.post(ctx, samlClientKeyUrl(realmId, clientId, keyType)+"/generate", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var certificate samlClientCertificate
+	err = json.Unmarshal(body, &certificate)
+	if err != nil {
+		return nil, err
+	}
+
+	return newSamlClientKey(realmId, clientId, keyType, &certificate)
+}
@@ -0,0 +1,53 @@
+package keycloak
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// GetSamlClientMetadata downloads the metadata of a SAML service provider. The request uses the same TLS settings as the
+// requests sent to Keycloak, but does not carry the access token of the provider.
+func (keycloakClient *KeycloakClient) GetSamlClientMetadata(ctx context.Context, metadataUrl string) (string, error) {
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, metadataUrl, nil)
+	if err != nil {
+		return "", err
+	}
+
+	request.Header.Set("Accept", "application/samlmetadata+xml, application/xml, text/xml")
+	if keycloakClient.userAgent != "" {
+		request.Header.Set("User-Agent", keycloakClient.userAgent)
+	}
+
+	response, err := keycloakClient.httpClient.Do(request)
+	if err != nil {
+		return "", fmt.Errorf("error fetching saml metadata from %s: %v", metadataUrl, err)
+	}
+	defer response.Body.Close()
+
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		return "", err
+	}
+
+	if response.StatusCode >= 400 {
+		return "", fmt.Errorf("error fetching saml metadata from %s: %s", metadataUrl, response.Status)
+	}
+
+	return string(body), nil
+}
+
+// NewSamlClientDescriptionFromMetadata converts the metadata of a SAML service provider into the representation of a client
+func (keycloakClient *KeycloakClient) NewSamlClientDescriptionFromMetadata(ctx context.Context, realmId, metadata string) (*GenericClientRepresentation, error) {
+	description, err := keycloakClient.NewGenericClientDescription(ctx, realmId, metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	if description.Protocol != "saml" {
+		return nil, fmt.Errorf("the metadata does not describe a saml service provider")
+	}
+
+	return description, nil
+}
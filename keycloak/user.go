@@ -3,6 +3,9 @@ package keycloak
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strconv"
+	"strings"
 )
 
 type FederatedIdentity struct {
@@ -26,6 +29,7 @@ type User struct {
 	Attributes          map[string][]string `json:"attributes"`
 	FederatedIdentities FederatedIdentities `json:"federatedIdentities"`
 	RequiredActions     []string            `json:"requiredActions"`
+	FederationLink      string              `json:"federationLink,omitempty"`
 }
 
 type PasswordCredentials struct {
@@ -93,6 +97,61 @@ func (keycloakClient *KeycloakClient) GetUsers(ctx context.Context, realmId stri
 	return users, nil
 }
 
+// SearchUsers pages through every user matching the given query parameters of the admin API, such as search, email or exact
+func (keycloakClient *KeycloakClient) SearchUsers(ctx context.Context, realmId string, params map[string]string, attributes map[string]string) ([]*User, error) {
+	var users []*User
+	var first, pagination int = 0, 100
+
+	query := map[string]string{}
+	for key, value := range params {
+		query[key] = value
+	}
+	if len(attributes) != 0 {
+		query["q"] = formatUserAttributeQuery(attributes)
+	}
+
+	for {
+		var iterationUsers []*User
+
+		query["first"] = strconv.Itoa(first)
+		query["max"] = strconv.Itoa(pagination)
+
+		err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/users", realmId), &iterationUsers, query)
+		if err != nil {
+			return nil, err
+		}
+
+		users = append(users, iterationUsers...)
+
+		if len(iterationUsers) < pagination {
+			break
+		}
+		first += pagination
+	}
+
+	for _, user := range users {
+		user.RealmId = realmId
+	}
+
+	return users, nil
+}
+
+// formatUserAttributeQuery builds the q parameter, which is a space separated list of key:value pairs
+func formatUserAttributeQuery(attributes map[string]string) string {
+	var keys []string
+	for key := range attributes {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var pairs []string
+	for _, key := range keys {
+		pairs = append(pairs, fmt.Sprintf("%s:%s", key, attributes[key]))
+	}
+
+	return strings.Join(pairs, " ")
+}
+
 func (keycloakClient *KeycloakClient) GetUser(ctx context.Context, realmId, id string) (*User, error) {
 	var user User
 
@@ -112,24 +171,12 @@ func (keycloakClient *KeycloakClient) UpdateUser(ctx context.Context, user *User
 		return err
 	}
 
-	var federatedIdentities []*FederatedIdentity
-	err = keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/users/%s/federated-identity", user.RealmId, user.Id), &federatedIdentities, nil)
-	if err != nil {
-		return err
-	}
-
-	for _, federatedIdentity := range federatedIdentities {
-		keycloakClient.delete(ctx, fmt.Sprintf("/realms/%s/users/%s/federated-identity/%s", user.RealmId, user.Id, federatedIdentity.IdentityProvider), nil)
-	}
-
-	for _, federatedIdentity := range user.FederatedIdentities {
-		_, _, err := keycloakClient.post(ctx, fmt.Sprintf("/realms/%s/users/%s/federated-identity/%s", user.RealmId, user.Id, federatedIdentity.IdentityProvider), federatedIdentity)
-		if err != nil {
-			return err
-		}
+	// a nil slice leaves the links of the user untouched
+	if user.FederatedIdentities == nil {
+		return nil
 	}
 
-	return nil
+	return keycloakClient.updateUserFederatedIdentities(ctx, user.RealmId, user.Id, user.FederatedIdentities)
 }
 
 func (keycloakClient *KeycloakClient) DeleteUser(ctx context.Context, realmId, id string) error {
@@ -178,15 +225,34 @@ func (keycloakClient *KeycloakClient) addUserToGroup(ctx context.Context, user *
 	return keycloakClient.put(ctx, fmt.Sprintf("/realms/%s/users/%s/groups/%s", user.RealmId, user.Id, groupId), nil)
 }
 
+// GetGroupMember finds the user referred to by a group member, which is either a username or a user id
+func (keycloakClient *KeycloakClient) GetGroupMember(ctx context.Context, realmId, member string) (*User, error) {
+	user, err := keycloakClient.GetUserByUsername(ctx, realmId, member)
+	if err != nil {
+		return nil, err
+	}
+	if user != nil {
+		return user, nil
+	}
+
+	user, err = keycloakClient.GetUser(ctx, realmId, member)
+	if err != nil {
+		if ErrorIs404(err) {
+			return nil, fmt.Errorf("user with username or id %s does not exist", member)
+		}
+
+		return nil, err
+	}
+
+	return user, nil
+}
+
 func (keycloakClient *KeycloakClient) AddUsersToGroup(ctx context.Context, realmId, groupId string, users []interface{}) error {
-	for _, username := range users {
-		user, err := keycloakClient.GetUserByUsername(ctx, realmId, username.(string)) // we need the user's id in order to add them to a group
+	for _, member := range users {
+		user, err := keycloakClient.GetGroupMember(ctx, realmId, member.(string)) // we need the user's id in order to add them to a group
 		if err != nil {
 			return err
 		}
-		if user == nil {
-			return fmt.Errorf("user with username %s does not exist", username.(string))
-		}
 
 		err = keycloakClient.addUserToGroup(ctx, user, groupId)
 		if err != nil {
@@ -201,15 +267,12 @@ func (keycloakClient *KeycloakClient) RemoveUserFromGroup(ctx context.Context, u
 	return keycloakClient.delete(ctx, fmt.Sprintf("/realms/%s/users/%s/groups/%s", user.RealmId, user.Id, groupId), nil)
 }
 
-func (keycloakClient *KeycloakClient) RemoveUsersFromGroup(ctx context.Context, realmId, groupId string, usernames []interface{}) error {
-	for _, username := range usernames {
-		user, err := keycloakClient.GetUserByUsername(ctx, realmId, username.(string)) // we need the user's id in order to remove them from a group
+func (keycloakClient *KeycloakClient) RemoveUsersFromGroup(ctx context.Context, realmId, groupId string, users []interface{}) error {
+	for _, member := range users {
+		user, err := keycloakClient.GetGroupMember(ctx, realmId, member.(string)) // we need the user's id in order to remove them from a group
 		if err != nil {
 			return err
 		}
-		if user == nil {
-			return fmt.Errorf("user with username %s does not exist", username.(string))
-		}
 
 		err = keycloakClient.RemoveUserFromGroup(ctx, user, groupId)
 		if err != nil {
@@ -0,0 +1,50 @@
+package keycloak
+
+import (
+	"context"
+	"fmt"
+)
+
+// https://www.keycloak.org/docs-api/19.0.0/rest-api/index.html#_credentialrepresentation
+type UserCredential struct {
+	Id          string `json:"id"`
+	Type        string `json:"type"`
+	UserLabel   string `json:"userLabel,omitempty"`
+	CreatedDate int64  `json:"createdDate,omitempty"`
+}
+
+func (keycloakClient *KeycloakClient) GetUserCredentials(ctx context.Context, realmId, userId string) ([]*UserCredential, error) {
+	var credentials []*UserCredential
+
+	err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/users/%s/credentials", realmId, userId), &credentials, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return credentials, nil
+}
+
+func (keycloakClient *KeycloakClient) DeleteUserCredential(ctx context.Context, realmId, userId, credentialId string) error {
+	return keycloakClient.delete(ctx, fmt.Sprintf("/realms/%s/users/%s/credentials/%s", realmId, userId, credentialId), nil)
+}
+
+// DeleteUserCredentialsByType deletes all credentials of the given type, such as "otp" or "webauthn"
+func (keycloakClient *KeycloakClient) DeleteUserCredentialsByType(ctx context.Context, realmId, userId, credentialType string) error {
+	credentials, err := keycloakClient.GetUserCredentials(ctx, realmId, userId)
+	if err != nil {
+		return err
+	}
+
+	for _, credential := range credentials {
+		if credential.Type != credentialType {
+			continue
+		}
+
+		err = keycloakClient.DeleteUserCredential(ctx, realmId, userId, credential.Id)
+		if err != nil && !ErrorIs404(err) {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,81 @@
+package keycloak
+
+import (
+	"context"
+	"fmt"
+)
+
+func (keycloakClient *KeycloakClient) GetUserFederatedIdentities(ctx context.Context, realmId, userId string) (FederatedIdentities, error) {
+	var federatedIdentities FederatedIdentities
+
+	err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/users/%s/federated-identity", realmId, userId), &federatedIdentities, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return federatedIdentities, nil
+}
+
+// GetUserFederatedIdentity returns nil when the user is not linked to the identity provider
+func (keycloakClient *KeycloakClient) GetUserFederatedIdentity(ctx context.Context, realmId, userId, identityProvider string) (*FederatedIdentity, error) {
+	federatedIdentities, err := keycloakClient.GetUserFederatedIdentities(ctx, realmId, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, federatedIdentity := range federatedIdentities {
+		if federatedIdentity.IdentityProvider == identityProvider {
+			return federatedIdentity, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (keycloakClient *KeycloakClient) NewUserFederatedIdentity(ctx context.Context, realmId, userId string, federatedIdentity *FederatedIdentity) error {
+	_, _, err := keycloakClient.post(ctx, fmt.Sprintf("/realms/%s/users/%s/federated-identity/%s", realmId, userId, federatedIdentity.IdentityProvider), federatedIdentity)
+
+	return err
+}
+
+func (keycloakClient *KeycloakClient) DeleteUserFederatedIdentity(ctx context.Context, realmId, userId, identityProvider string) error {
+	return keycloakClient.delete(ctx, fmt.Sprintf("/realms/%s/users/%s/federated-identity/%s", realmId, userId, identityProvider), nil)
+}
+
+// updateUserFederatedIdentities only removes and adds the links that differ, so that unchanged links are never interrupted
+func (keycloakClient *KeycloakClient) updateUserFederatedIdentities(ctx context.Context, realmId, userId string, federatedIdentities FederatedIdentities) error {
+	currentFederatedIdentities, err := keycloakClient.GetUserFederatedIdentities(ctx, realmId, userId)
+	if err != nil {
+		return err
+	}
+
+	for _, current := range currentFederatedIdentities {
+		if !federatedIdentities.contains(current) {
+			err := keycloakClient.DeleteUserFederatedIdentity(ctx, realmId, userId, current.IdentityProvider)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, federatedIdentity := range federatedIdentities {
+		if !currentFederatedIdentities.contains(federatedIdentity) {
+			err := keycloakClient.NewUserFederatedIdentity(ctx, realmId, userId, federatedIdentity)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (federatedIdentities FederatedIdentities) contains(federatedIdentity *FederatedIdentity) bool {
+	for _, f := range federatedIdentities {
+		if f.IdentityProvider == federatedIdentity.IdentityProvider && f.UserId == federatedIdentity.UserId && f.UserName == federatedIdentity.UserName {
+			return true
+		}
+	}
+
+	return false
+}
@@ -8,7 +8,7 @@ import (
 )
 
 func dataSourceKeycloakGroup() *schema.Resource {
-	return &schema.Resource{
+	dataSource := &schema.Resource{
 		ReadContext: dataSourceKeycloakGroupRead,
 		Schema: map[string]*schema.Schema{
 			"realm_id": {
@@ -16,16 +16,20 @@ func dataSourceKeycloakGroup() *schema.Resource {
 				Required: true,
 			},
 			"name": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"name", "path"},
 			},
 			"parent_id": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
 			"path": {
-				Type:     schema.TypeString,
-				Computed: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"name", "path"},
 			},
 			"attributes": {
 				Type:     schema.TypeMap,
@@ -33,20 +37,38 @@ func dataSourceKeycloakGroup() *schema.Resource {
 			},
 		},
 	}
+
+	dataSource.Schema = mergeSchemas(dataSource.Schema, effectiveRolesSchema())
+
+	return dataSource
 }
 
 func dataSourceKeycloakGroupRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
 	realmId := data.Get("realm_id").(string)
-	groupName := data.Get("name").(string)
 
-	group, err := keycloakClient.GetGroupByName(ctx, realmId, groupName)
+	var group *keycloak.Group
+	var err error
+	if groupPath, ok := data.GetOk("path"); ok {
+		group, err = keycloakClient.GetGroupByPath(ctx, realmId, groupPath.(string))
+	} else {
+		group, err = keycloakClient.GetGroupByName(ctx, realmId, data.Get("name").(string))
+	}
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
 	mapFromGroupToData(data, group)
 
+	if data.Get("include_effective_roles").(bool) {
+		effectiveRoles, err := keycloakClient.GetGroupEffectiveRoles(ctx, realmId, group.Id)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		setEffectiveRolesData(data, effectiveRoles)
+	}
+
 	return nil
 }
@@ -65,6 +65,32 @@ func TestAccKeycloakDataSourceGroup_nested(t *testing.T) {
 	})
 }
 
+func TestAccKeycloakDataSourceGroup_path(t *testing.T) {
+	t.Parallel()
+
+	engineering := acctest.RandomWithPrefix("tf-acc")
+	sales := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakRoleDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceKeycloakGroup_path(engineering, sales),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("keycloak_group.sales_admins", "id", "data.keycloak_group.sales_admins", "id"),
+					resource.TestCheckResourceAttrPair("keycloak_group.sales", "id", "data.keycloak_group.sales_admins", "parent_id"),
+					resource.TestCheckResourceAttr("data.keycloak_group.sales_admins", "name", "Admins"),
+					resource.TestCheckResourceAttr("data.keycloak_group.sales_admins", "path", fmt.Sprintf("/%s/Admins", sales)),
+					resource.TestCheckResourceAttrPair("keycloak_group.engineering_admins", "id", "data.keycloak_group.engineering_admins", "id"),
+					resource.TestCheckResourceAttrPair("keycloak_group.engineering", "id", "data.keycloak_group.engineering_admins", "parent_id"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckDataKeycloakGroup(resourceName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[resourceName]
@@ -154,3 +180,51 @@ data "keycloak_group" "group_nested" {
 }
 	`, testAccRealm.Realm, group, groupNested)
 }
+
+func testDataSourceKeycloakGroup_path(engineering, sales string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_group" "engineering" {
+	name     = "%s"
+	realm_id = data.keycloak_realm.realm.id
+}
+
+resource "keycloak_group" "engineering_admins" {
+	name      = "Admins"
+	parent_id = keycloak_group.engineering.id
+	realm_id  = data.keycloak_realm.realm.id
+}
+
+resource "keycloak_group" "sales" {
+	name     = "%s"
+	realm_id = data.keycloak_realm.realm.id
+}
+
+resource "keycloak_group" "sales_admins" {
+	name      = "Admins"
+	parent_id = keycloak_group.sales.id
+	realm_id  = data.keycloak_realm.realm.id
+}
+
+data "keycloak_group" "engineering_admins" {
+	realm_id = data.keycloak_realm.realm.id
+	path     = "/${keycloak_group.engineering.name}/Admins"
+
+	depends_on = [
+		keycloak_group.engineering_admins,
+	]
+}
+
+data "keycloak_group" "sales_admins" {
+	realm_id = data.keycloak_realm.realm.id
+	path     = "/${keycloak_group.sales.name}/Admins"
+
+	depends_on = [
+		keycloak_group.sales_admins,
+	]
+}
+	`, testAccRealm.Realm, engineering, sales)
+}
@@ -0,0 +1,292 @@
+package provider
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+)
+
+func dataSourceKeycloakGroups() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceKeycloakGroupsRead,
+		Schema: map[string]*schema.Schema{
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"parent_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"parent_path"},
+			},
+			"parent_path": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"parent_id"},
+			},
+			"groups": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"path": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"parent_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"attributes": {
+							Type:     schema.TypeMap,
+							Computed: true,
+						},
+						"realm_roles": {
+							Type:     schema.TypeSet,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Computed: true,
+						},
+						"client_roles": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"client_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"roles": {
+										Type:     schema.TypeSet,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+										Computed: true,
+									},
+								},
+							},
+						},
+						"member_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"sub_group_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// groupRoles holds the realm roles and the client roles, keyed by client id, that a group grants
+type groupRoles struct {
+	realmRoles  map[string]bool
+	clientRoles map[string]map[string]bool
+}
+
+func newGroupRoles() *groupRoles {
+	return &groupRoles{
+		realmRoles:  map[string]bool{},
+		clientRoles: map[string]map[string]bool{},
+	}
+}
+
+// with adds the direct role mappings of a group to the roles inherited from its parents
+func (r *groupRoles) with(roleMapping *keycloak.RoleMapping) *groupRoles {
+	roles := newGroupRoles()
+
+	for role := range r.realmRoles {
+		roles.realmRoles[role] = true
+	}
+	for clientId, clientRoles := range r.clientRoles {
+		roles.clientRoles[clientId] = map[string]bool{}
+		for role := range clientRoles {
+			roles.clientRoles[clientId][role] = true
+		}
+	}
+
+	if roleMapping == nil {
+		return roles
+	}
+
+	for _, role := range roleMapping.RealmMappings {
+		roles.realmRoles[role.Name] = true
+	}
+	for clientId, clientMapping := range roleMapping.ClientMappings {
+		if _, ok := roles.clientRoles[clientId]; !ok {
+			roles.clientRoles[clientId] = map[string]bool{}
+		}
+		for _, role := range clientMapping.Mappings {
+			roles.clientRoles[clientId][role.Name] = true
+		}
+	}
+
+	return roles
+}
+
+func (r *groupRoles) flatten() ([]string, []interface{}) {
+	var realmRoles []string
+	for role := range r.realmRoles {
+		realmRoles = append(realmRoles, role)
+	}
+
+	var clientIds []string
+	for clientId := range r.clientRoles {
+		clientIds = append(clientIds, clientId)
+	}
+	sort.Strings(clientIds)
+
+	var clientRoles []interface{}
+	for _, clientId := range clientIds {
+		var roles []string
+		for role := range r.clientRoles[clientId] {
+			roles = append(roles, role)
+		}
+
+		clientRoles = append(clientRoles, map[string]interface{}{
+			"client_id": clientId,
+			"roles":     roles,
+		})
+	}
+
+	return realmRoles, clientRoles
+}
+
+func dataSourceKeycloakGroupsRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	parentId := data.Get("parent_id").(string)
+
+	if parentPath, ok := data.GetOk("parent_path"); ok {
+		parent, err := keycloakClient.GetGroupByPath(ctx, realmId, parentPath.(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		parentId = parent.Id
+	}
+
+	var children []*keycloak.Group
+	inheritedRoles := newGroupRoles()
+
+	if parentId == "" {
+		groups, err := keycloakClient.GetGroups(ctx, realmId)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		children = groups
+	} else {
+		parent, err := keycloakClient.GetGroup(ctx, realmId, parentId)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		children, err = keycloakClient.GetSubGroups(ctx, parent)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		// members of the returned groups also receive the roles of the parent and of its own parents
+		for ancestor := parent; ; {
+			roleMapping, err := keycloakClient.GetGroupRoleMappings(ctx, realmId, ancestor.Id)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			inheritedRoles = inheritedRoles.with(roleMapping)
+
+			if ancestor.ParentId == "" {
+				break
+			}
+
+			ancestor, err = keycloakClient.GetGroup(ctx, realmId, ancestor.ParentId)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	groups, err := getGroupTree(ctx, keycloakClient, realmId, children, inheritedRoles)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data.Set("groups", groups)
+	data.SetId(realmId + "/" + parentId)
+
+	return nil
+}
+
+// getGroupTree flattens the given groups and all of their descendants, parents come before their children
+func getGroupTree(ctx context.Context, keycloakClient *keycloak.KeycloakClient, realmId string, groups []*keycloak.Group, inheritedRoles *groupRoles) ([]interface{}, error) {
+	var tree []interface{}
+
+	for _, g := range groups {
+		// groups in a listing lack their attributes, so every group is fetched on its own
+		group, err := keycloakClient.GetGroup(ctx, realmId, g.Id)
+		if err != nil {
+			return nil, err
+		}
+
+		roleMapping, err := keycloakClient.GetGroupRoleMappings(ctx, realmId, group.Id)
+		if err != nil {
+			return nil, err
+		}
+
+		memberCount, err := keycloakClient.GetGroupMemberCount(ctx, realmId, group.Id)
+		if err != nil {
+			return nil, err
+		}
+
+		subGroups, err := keycloakClient.GetSubGroups(ctx, group)
+		if err != nil {
+			return nil, err
+		}
+
+		attributes := map[string]string{}
+		for k, v := range group.Attributes {
+			if k == keycloak.OwnershipTagAttribute {
+				continue
+			}
+			attributes[k] = strings.Join(v, MULTIVALUE_ATTRIBUTE_SEPARATOR)
+		}
+
+		roles := inheritedRoles.with(roleMapping)
+		realmRoles, clientRoles := roles.flatten()
+
+		tree = append(tree, map[string]interface{}{
+			"id":              group.Id,
+			"name":            group.Name,
+			"path":            group.Path,
+			"parent_id":       group.ParentId,
+			"attributes":      attributes,
+			"realm_roles":     realmRoles,
+			"client_roles":    clientRoles,
+			"member_count":    memberCount,
+			"sub_group_count": len(subGroups),
+		})
+
+		subTree, err := getGroupTree(ctx, keycloakClient, realmId, subGroups, roles)
+		if err != nil {
+			return nil, err
+		}
+
+		tree = append(tree, subTree...)
+	}
+
+	return tree, nil
+}
@@ -0,0 +1,131 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccKeycloakDataSourceGroups_tree(t *testing.T) {
+	t.Parallel()
+
+	prefix := acctest.RandomWithPrefix("tf-acc")
+	dataSourceName := "data.keycloak_groups.groups"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakGroupDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceKeycloakGroups_tree(prefix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "groups.#", "2"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "groups.0.id", "keycloak_group.child", "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "groups.0.parent_id", "keycloak_group.parent", "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "groups.0.attributes.foo", "bar"),
+					resource.TestCheckResourceAttr(dataSourceName, "groups.0.member_count", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "groups.0.sub_group_count", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "groups.0.realm_roles.#", "2"),
+					resource.TestCheckTypeSetElemAttr(dataSourceName, "groups.0.realm_roles.*", prefix+"-parent-role"),
+					resource.TestCheckTypeSetElemAttr(dataSourceName, "groups.0.realm_roles.*", prefix+"-child-role"),
+					resource.TestCheckResourceAttr(dataSourceName, "groups.0.client_roles.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "groups.0.client_roles.0.client_id", prefix+"-client"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "groups.1.id", "keycloak_group.grandchild", "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "groups.1.parent_id", "keycloak_group.child", "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "groups.1.member_count", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "groups.1.realm_roles.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "groups.1.client_roles.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testDataSourceKeycloakGroups_tree(prefix string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_role" "parent_role" {
+	realm_id = data.keycloak_realm.realm.id
+	name     = "%s-parent-role"
+}
+
+resource "keycloak_role" "child_role" {
+	realm_id = data.keycloak_realm.realm.id
+	name     = "%s-child-role"
+}
+
+resource "keycloak_openid_client" "client" {
+	realm_id    = data.keycloak_realm.realm.id
+	client_id   = "%s-client"
+	access_type = "PUBLIC"
+}
+
+resource "keycloak_role" "client_role" {
+	realm_id  = data.keycloak_realm.realm.id
+	client_id = keycloak_openid_client.client.id
+	name      = "%s-client-role"
+}
+
+resource "keycloak_group" "parent" {
+	realm_id = data.keycloak_realm.realm.id
+	name     = "%s-parent"
+}
+
+resource "keycloak_group" "child" {
+	realm_id  = data.keycloak_realm.realm.id
+	parent_id = keycloak_group.parent.id
+	name      = "child"
+
+	attributes = {
+		foo = "bar"
+	}
+}
+
+resource "keycloak_group" "grandchild" {
+	realm_id  = data.keycloak_realm.realm.id
+	parent_id = keycloak_group.child.id
+	name      = "grandchild"
+}
+
+resource "keycloak_group_roles" "parent" {
+	realm_id = data.keycloak_realm.realm.id
+	group_id = keycloak_group.parent.id
+	role_ids = [keycloak_role.parent_role.id]
+}
+
+resource "keycloak_group_roles" "child" {
+	realm_id = data.keycloak_realm.realm.id
+	group_id = keycloak_group.child.id
+	role_ids = [keycloak_role.child_role.id, keycloak_role.client_role.id]
+}
+
+resource "keycloak_user" "user" {
+	realm_id = data.keycloak_realm.realm.id
+	username = "%s-user"
+}
+
+resource "keycloak_group_memberships" "child" {
+	realm_id = data.keycloak_realm.realm.id
+	group_id = keycloak_group.child.id
+	members  = [keycloak_user.user.username]
+}
+
+data "keycloak_groups" "groups" {
+	realm_id    = data.keycloak_realm.realm.id
+	parent_path = "/${keycloak_group.parent.name}"
+
+	depends_on = [
+		keycloak_group.grandchild,
+		keycloak_group_roles.parent,
+		keycloak_group_roles.child,
+		keycloak_group_memberships.child,
+	]
+}
+	`, testAccRealm.Realm, prefix, prefix, prefix, prefix, prefix, prefix)
+}
@@ -172,6 +172,90 @@ func dataSourceKeycloakOpenidClient() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"client_jwt": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"jwks_url": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"certificate": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"jwks": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"signing_alg": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"client_x509": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"subject_dn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"allow_regex_pattern_comparison": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"token_security": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"access_token_signed_response_alg": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"id_token_signed_response_alg": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"id_token_encrypted_response_alg": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"id_token_encrypted_response_enc": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"user_info_response_signature_alg": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"request_object_signature_alg": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"request_object_required": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"authorization_signed_response_alg": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"tls_client_certificate_bound_access_tokens": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"authentication_flow_binding_overrides": {
 				Type:     schema.TypeSet,
 				Computed: true,
@@ -234,6 +318,36 @@ func dataSourceKeycloakOpenidClient() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"ciba_grant_enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"ciba_backchannel_token_delivery_mode": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ciba_backchannel_client_notification_endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ciba_backchannel_auth_request_signing_alg": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"require_pushed_authorization_requests": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"acr_loa_mapping": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeInt},
+			},
+			"default_acr_values": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 		},
 	}
 }
@@ -0,0 +1,106 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/base64"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+)
+
+func dataSourceKeycloakOpenidClientInstallationProvider() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceKeycloakOpenidClientInstallationProviderRead,
+		Schema: map[string]*schema.Schema{
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"client_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"provider_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"value": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"realm": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"auth_server_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"resource": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ssl_required": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"public_client": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"bearer_only": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"credentials": {
+				Type:      schema.TypeMap,
+				Elem:      &schema.Schema{Type: schema.TypeString},
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func dataSourceKeycloakOpenidClientInstallationProviderRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	clientId := data.Get("client_id").(string)
+	providerId := data.Get("provider_id").(string)
+
+	value, err := keycloakClient.GetClientInstallationProvider(ctx, realmId, clientId, providerId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	installation, err := keycloak.ParseOpenidClientInstallation(providerId, value)
+	if err != nil {
+		return diag.Errorf("unable to parse the %s document of client %s: %s", providerId, clientId, err)
+	}
+
+	h := sha1.New()
+	h.Write(value)
+	id := base64.URLEncoding.EncodeToString(h.Sum(nil))
+
+	data.SetId(id)
+	data.Set("realm_id", realmId)
+	data.Set("client_id", clientId)
+	data.Set("provider_id", providerId)
+	data.Set("value", string(value))
+
+	if installation != nil {
+		data.Set("realm", installation.Realm)
+		data.Set("auth_server_url", installation.AuthServerUrl)
+		data.Set("resource", installation.Resource)
+		data.Set("ssl_required", installation.SslRequired)
+		data.Set("public_client", installation.PublicClient)
+		data.Set("bearer_only", installation.BearerOnly)
+		data.Set("credentials", installation.Credentials)
+	}
+
+	return nil
+}
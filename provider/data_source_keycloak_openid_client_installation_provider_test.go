@@ -0,0 +1,96 @@
+package provider
+
+import (
+	"encoding/xml"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccKeycloakDataSourceOpenidClientInstallationProvider_keycloakJson(t *testing.T) {
+	t.Parallel()
+	clientId := acctest.RandomWithPrefix("tf-acc")
+
+	resourceName := "keycloak_openid_client.client"
+	dataSourceName := "data.keycloak_openid_client_installation_provider.keycloak_json"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakOpenidClientDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceKeycloakOpenidClientInstallationProvider_basic(clientId, "keycloak_json", "keycloak-oidc-keycloak-json"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "client_id", resourceName, "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "realm", testAccRealm.Realm),
+					resource.TestCheckResourceAttr(dataSourceName, "resource", clientId),
+					resource.TestCheckResourceAttrSet(dataSourceName, "auth_server_url"),
+					resource.TestCheckResourceAttr(dataSourceName, "public_client", "false"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "credentials.secret", resourceName, "client_secret"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakDataSourceOpenidClientInstallationProvider_jbossSubsystem(t *testing.T) {
+	t.Parallel()
+	clientId := acctest.RandomWithPrefix("tf-acc")
+
+	dataSourceName := "data.keycloak_openid_client_installation_provider.jboss_subsystem"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakOpenidClientDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceKeycloakOpenidClientInstallationProvider_basic(clientId, "jboss_subsystem", "keycloak-oidc-jboss-subsystem"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "realm", testAccRealm.Realm),
+					resource.TestCheckResourceAttr(dataSourceName, "resource", clientId),
+					resource.TestCheckResourceAttrPair(dataSourceName, "credentials.secret", "keycloak_openid_client.client", "client_secret"),
+					func(s *terraform.State) error {
+						rs, ok := s.RootModule().Resources[dataSourceName]
+						if !ok {
+							return fmt.Errorf("resource not found: %s", dataSourceName)
+						}
+
+						value := rs.Primary.Attributes["value"]
+
+						err := xml.Unmarshal([]byte(value), new(interface{}))
+						if err != nil {
+							return fmt.Errorf("invalid XML: %s\n%s", err, value)
+						}
+
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func testDataSourceKeycloakOpenidClientInstallationProvider_basic(clientId, name, providerId string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_openid_client" "client" {
+	realm_id    = data.keycloak_realm.realm.id
+	client_id   = "%s"
+	access_type = "CONFIDENTIAL"
+}
+
+data "keycloak_openid_client_installation_provider" "%s" {
+	realm_id    = data.keycloak_realm.realm.id
+	client_id   = keycloak_openid_client.client.id
+	provider_id = "%s"
+}
+	`, testAccRealm.Realm, clientId, name, providerId)
+}
@@ -9,7 +9,7 @@ import (
 )
 
 func dataSourceKeycloakOpenidClientServiceAccountUser() *schema.Resource {
-	return &schema.Resource{
+	dataSource := &schema.Resource{
 		ReadContext: dataSourceKeycloakOpenidClientServiceAccountUserRead,
 		Schema: map[string]*schema.Schema{
 			"realm_id": {
@@ -75,6 +75,10 @@ func dataSourceKeycloakOpenidClientServiceAccountUser() *schema.Resource {
 			},
 		},
 	}
+
+	dataSource.Schema = mergeSchemas(dataSource.Schema, effectiveRolesSchema())
+
+	return dataSource
 }
 
 func dataSourceKeycloakOpenidClientServiceAccountUserRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -90,5 +94,14 @@ func dataSourceKeycloakOpenidClientServiceAccountUserRead(ctx context.Context, d
 
 	mapFromUserToData(data, user)
 
+	if data.Get("include_effective_roles").(bool) {
+		effectiveRoles, err := keycloakClient.GetUserEffectiveRoles(ctx, realmId, user.Id)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		setEffectiveRolesData(data, effectiveRoles)
+	}
+
 	return nil
 }
@@ -0,0 +1,152 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+)
+
+func dataSourceKeycloakOpenidClients() *schema.Resource {
+	dataSource := &schema.Resource{
+		ReadContext: dataSourceKeycloakOpenidClientsRead,
+		Schema: map[string]*schema.Schema{
+			"access_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(keycloakOpenidClientAccessTypes, false),
+			},
+			"include_secrets": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"clients": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"client_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"enabled": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"access_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"client_secret": {
+							Type:      schema.TypeString,
+							Computed:  true,
+							Sensitive: true,
+						},
+						"valid_redirect_uris": {
+							Type:     schema.TypeSet,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Set:      schema.HashString,
+							Computed: true,
+						},
+						"standard_flow_enabled": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"implicit_flow_enabled": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"direct_access_grants_enabled": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"service_accounts_enabled": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"attributes": {
+							Type:     schema.TypeMap,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	dataSource.Schema = mergeSchemas(dataSource.Schema, clientListFilterSchema())
+
+	return dataSource
+}
+
+func dataSourceKeycloakOpenidClientsRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	accessTypeFilter := data.Get("access_type").(string)
+	includeSecrets := data.Get("include_secrets").(bool)
+
+	clients, err := getFilteredGenericClients(ctx, keycloakClient, data, "openid-connect")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var clientList []interface{}
+	for _, client := range clients {
+		accessType := "CONFIDENTIAL"
+		if client.PublicClient {
+			accessType = "PUBLIC"
+		} else if client.BearerOnly {
+			accessType = "BEARER-ONLY"
+		}
+
+		if accessTypeFilter != "" && accessType != accessTypeFilter {
+			continue
+		}
+
+		// public clients don't have a secret
+		var clientSecret string
+		if includeSecrets && !client.PublicClient {
+			clientSecret, err = keycloakClient.GetOpenidClientSecret(ctx, realmId, client.Id)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+		}
+
+		clientList = append(clientList, map[string]interface{}{
+			"id":                           client.Id,
+			"client_id":                    client.ClientId,
+			"name":                         client.Name,
+			"description":                  client.Description,
+			"enabled":                      client.Enabled,
+			"access_type":                  accessType,
+			"client_secret":                clientSecret,
+			"valid_redirect_uris":          client.ValidRedirectUris,
+			"standard_flow_enabled":        client.StandardFlowEnabled,
+			"implicit_flow_enabled":        client.ImplicitFlowEnabled,
+			"direct_access_grants_enabled": client.DirectAccessGrantsEnabled,
+			"service_accounts_enabled":     client.ServiceAccountsEnabled,
+			"attributes":                   client.Attributes,
+		})
+	}
+
+	data.Set("clients", clientList)
+	data.SetId(realmId)
+
+	return nil
+}
@@ -0,0 +1,138 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccKeycloakDataSourceOpenidClients_filters(t *testing.T) {
+	t.Parallel()
+	prefix := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakOpenidClientDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceKeycloakOpenidClients_filters(prefix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.keycloak_openid_clients.prefix", "clients.#", "3"),
+					resource.TestCheckResourceAttr("data.keycloak_openid_clients.public", "clients.#", "1"),
+					resource.TestCheckResourceAttr("data.keycloak_openid_clients.public", "clients.0.client_id", prefix+"-public"),
+					resource.TestCheckResourceAttr("data.keycloak_openid_clients.public", "clients.0.client_secret", ""),
+					resource.TestCheckResourceAttr("data.keycloak_openid_clients.regex", "clients.#", "2"),
+					resource.TestCheckResourceAttr("data.keycloak_openid_clients.disabled", "clients.#", "1"),
+					resource.TestCheckResourceAttr("data.keycloak_openid_clients.disabled", "clients.0.client_id", prefix+"-bearer"),
+					resource.TestCheckResourceAttr("data.keycloak_openid_clients.disabled", "clients.0.access_type", "BEARER-ONLY"),
+					resource.TestCheckResourceAttr("data.keycloak_openid_clients.attribute", "clients.#", "1"),
+					resource.TestCheckResourceAttr("data.keycloak_openid_clients.attribute", "clients.0.client_id", prefix+"-confidential"),
+					resource.TestCheckResourceAttr("data.keycloak_openid_clients.attribute", "clients.0.standard_flow_enabled", "true"),
+					resource.TestCheckResourceAttr("data.keycloak_openid_clients.attribute", "clients.0.valid_redirect_uris.#", "1"),
+					resource.TestCheckResourceAttrPair("data.keycloak_openid_clients.attribute", "clients.0.client_secret", "keycloak_openid_client.confidential", "client_secret"),
+				),
+			},
+		},
+	})
+}
+
+func testDataSourceKeycloakOpenidClients_filters(prefix string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_openid_client" "confidential" {
+	realm_id              = data.keycloak_realm.realm.id
+	client_id             = "%s-confidential"
+	access_type           = "CONFIDENTIAL"
+	standard_flow_enabled = true
+	valid_redirect_uris   = ["http://localhost:5555/callback"]
+
+	extra_config = {
+		"team" = "finance"
+	}
+}
+
+resource "keycloak_openid_client" "public" {
+	realm_id              = data.keycloak_realm.realm.id
+	client_id             = "%s-public"
+	access_type           = "PUBLIC"
+	standard_flow_enabled = true
+	valid_redirect_uris   = ["http://localhost:5555/callback"]
+}
+
+resource "keycloak_openid_client" "bearer" {
+	realm_id    = data.keycloak_realm.realm.id
+	client_id   = "%s-bearer"
+	access_type = "BEARER-ONLY"
+	enabled     = false
+}
+
+data "keycloak_openid_clients" "prefix" {
+	realm_id         = data.keycloak_realm.realm.id
+	client_id_prefix = "%s-"
+
+	depends_on = [
+		keycloak_openid_client.confidential,
+		keycloak_openid_client.public,
+		keycloak_openid_client.bearer,
+	]
+}
+
+data "keycloak_openid_clients" "public" {
+	realm_id         = data.keycloak_realm.realm.id
+	client_id_prefix = "%s-"
+	access_type      = "PUBLIC"
+	include_secrets  = true
+
+	depends_on = [
+		keycloak_openid_client.confidential,
+		keycloak_openid_client.public,
+		keycloak_openid_client.bearer,
+	]
+}
+
+data "keycloak_openid_clients" "regex" {
+	realm_id        = data.keycloak_realm.realm.id
+	client_id_regex = "^%s-(public|bearer)$"
+
+	depends_on = [
+		keycloak_openid_client.confidential,
+		keycloak_openid_client.public,
+		keycloak_openid_client.bearer,
+	]
+}
+
+data "keycloak_openid_clients" "disabled" {
+	realm_id         = data.keycloak_realm.realm.id
+	client_id_prefix = "%s-"
+	enabled          = false
+
+	depends_on = [
+		keycloak_openid_client.confidential,
+		keycloak_openid_client.public,
+		keycloak_openid_client.bearer,
+	]
+}
+
+data "keycloak_openid_clients" "attribute" {
+	realm_id         = data.keycloak_realm.realm.id
+	client_id_prefix = "%s-"
+	include_secrets  = true
+
+	attributes = {
+		"team" = "finance"
+	}
+
+	depends_on = [
+		keycloak_openid_client.confidential,
+		keycloak_openid_client.public,
+		keycloak_openid_client.bearer,
+	]
+}
+	`, testAccRealm.Realm, prefix, prefix, prefix, prefix, prefix, prefix, prefix, prefix)
+}
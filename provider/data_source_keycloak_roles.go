@@ -0,0 +1,148 @@
+package provider
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+)
+
+func dataSourceKeycloakRoles() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceKeycloakRolesRead,
+		Schema: map[string]*schema.Schema{
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"client_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"search": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"attributes": {
+				Type:     schema.TypeMap,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Optional: true,
+			},
+			"composite_only": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"roles": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"composite": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"composite_roles": {
+							Type:     schema.TypeSet,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Set:      schema.HashString,
+							Computed: true,
+						},
+						"attributes": {
+							Type:     schema.TypeMap,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// roleHasAttributes checks that every attribute has the given value among its values
+func roleHasAttributes(role *keycloak.Role, attributes map[string]interface{}) bool {
+	for key, value := range attributes {
+		found := false
+		for _, v := range role.Attributes[key] {
+			if v == value.(string) {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+func dataSourceKeycloakRolesRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	clientId := data.Get("client_id").(string)
+	attributes := data.Get("attributes").(map[string]interface{})
+	compositeOnly := data.Get("composite_only").(bool)
+
+	roles, err := keycloakClient.SearchRoles(ctx, realmId, clientId, data.Get("search").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var roleList []interface{}
+	for _, role := range roles {
+		if compositeOnly && !role.Composite {
+			continue
+		}
+		if !roleHasAttributes(role, attributes) {
+			continue
+		}
+
+		var compositeRoleIds []string
+		if role.Composite {
+			composites, err := keycloakClient.GetRoleComposites(ctx, role)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			for _, composite := range composites {
+				compositeRoleIds = append(compositeRoleIds, composite.Id)
+			}
+		}
+
+		roleAttributes := map[string]string{}
+		for k, v := range role.Attributes {
+			roleAttributes[k] = strings.Join(v, MULTIVALUE_ATTRIBUTE_SEPARATOR)
+		}
+
+		roleList = append(roleList, map[string]interface{}{
+			"id":              role.Id,
+			"name":            role.Name,
+			"description":     role.Description,
+			"composite":       role.Composite,
+			"composite_roles": compositeRoleIds,
+			"attributes":      roleAttributes,
+		})
+	}
+
+	data.Set("roles", roleList)
+	data.SetId(realmId + "/" + clientId)
+
+	return nil
+}
@@ -0,0 +1,143 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccKeycloakDataSourceRoles_realm(t *testing.T) {
+	t.Parallel()
+	prefix := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakRoleDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceKeycloakRoles_basic(prefix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.keycloak_roles.search", "roles.#", "3"),
+					resource.TestCheckResourceAttr("data.keycloak_roles.attribute", "roles.#", "1"),
+					resource.TestCheckResourceAttrPair("data.keycloak_roles.attribute", "roles.0.id", "keycloak_role.reader", "id"),
+					resource.TestCheckResourceAttr("data.keycloak_roles.attribute", "roles.0.attributes.team", "finance"),
+					resource.TestCheckResourceAttr("data.keycloak_roles.composite", "roles.#", "1"),
+					resource.TestCheckResourceAttrPair("data.keycloak_roles.composite", "roles.0.id", "keycloak_role.admin", "id"),
+					resource.TestCheckResourceAttr("data.keycloak_roles.composite", "roles.0.composite_roles.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakDataSourceRoles_client(t *testing.T) {
+	t.Parallel()
+	prefix := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakRoleDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceKeycloakRoles_client(prefix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.keycloak_roles.client", "roles.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testDataSourceKeycloakRoles_basic(prefix string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_role" "reader" {
+	realm_id = data.keycloak_realm.realm.id
+	name     = "%s-reader"
+
+	attributes = {
+		team = "finance"
+	}
+}
+
+resource "keycloak_role" "writer" {
+	realm_id = data.keycloak_realm.realm.id
+	name     = "%s-writer"
+}
+
+resource "keycloak_role" "admin" {
+	realm_id        = data.keycloak_realm.realm.id
+	name            = "%s-admin"
+	composite_roles = [keycloak_role.reader.id, keycloak_role.writer.id]
+}
+
+data "keycloak_roles" "search" {
+	realm_id = data.keycloak_realm.realm.id
+	search   = "%s"
+
+	depends_on = [
+		keycloak_role.admin,
+	]
+}
+
+data "keycloak_roles" "attribute" {
+	realm_id = data.keycloak_realm.realm.id
+	search   = "%s"
+
+	attributes = {
+		team = "finance"
+	}
+
+	depends_on = [
+		keycloak_role.admin,
+	]
+}
+
+data "keycloak_roles" "composite" {
+	realm_id       = data.keycloak_realm.realm.id
+	search         = "%s"
+	composite_only = true
+
+	depends_on = [
+		keycloak_role.admin,
+	]
+}
+	`, testAccRealm.Realm, prefix, prefix, prefix, prefix, prefix, prefix)
+}
+
+func testDataSourceKeycloakRoles_client(prefix string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_openid_client" "client" {
+	realm_id    = data.keycloak_realm.realm.id
+	client_id   = "%s-client"
+	access_type = "PUBLIC"
+}
+
+resource "keycloak_role" "client_role" {
+	count     = 2
+	realm_id  = data.keycloak_realm.realm.id
+	client_id = keycloak_openid_client.client.id
+	name      = "%s-${count.index}"
+}
+
+data "keycloak_roles" "client" {
+	realm_id  = data.keycloak_realm.realm.id
+	client_id = keycloak_openid_client.client.id
+
+	depends_on = [
+		keycloak_role.client_role,
+	]
+}
+	`, testAccRealm.Realm, prefix, prefix)
+}
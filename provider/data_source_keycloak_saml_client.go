@@ -150,6 +150,42 @@ func dataSourceKeycloakSamlClient() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"logout_service_artifact_binding_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"logout_service_soap_binding_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"artifact_binding": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"artifact_binding_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"artifact_resolution_service_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"allow_ecp_flow": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"encryption_algorithm": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"encryption_key_algorithm": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"encryption_digest_method": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"full_scope_allowed": {
 				Type:     schema.TypeBool,
 				Computed: true,
@@ -0,0 +1,86 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+)
+
+func dataSourceKeycloakSamlClients() *schema.Resource {
+	dataSource := &schema.Resource{
+		ReadContext: dataSourceKeycloakSamlClientsRead,
+		Schema: map[string]*schema.Schema{
+			"clients": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"client_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"enabled": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"valid_redirect_uris": {
+							Type:     schema.TypeSet,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Set:      schema.HashString,
+							Computed: true,
+						},
+						"attributes": {
+							Type:     schema.TypeMap,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	dataSource.Schema = mergeSchemas(dataSource.Schema, clientListFilterSchema())
+
+	return dataSource
+}
+
+func dataSourceKeycloakSamlClientsRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	clients, err := getFilteredGenericClients(ctx, keycloakClient, data, "saml")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var clientList []interface{}
+	for _, client := range clients {
+		clientList = append(clientList, map[string]interface{}{
+			"id":                  client.Id,
+			"client_id":           client.ClientId,
+			"name":                client.Name,
+			"description":         client.Description,
+			"enabled":             client.Enabled,
+			"valid_redirect_uris": client.ValidRedirectUris,
+			"attributes":          client.Attributes,
+		})
+	}
+
+	data.Set("clients", clientList)
+	data.SetId(data.Get("realm_id").(string))
+
+	return nil
+}
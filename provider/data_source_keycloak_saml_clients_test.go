@@ -0,0 +1,102 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccKeycloakDataSourceSamlClients_filters(t *testing.T) {
+	t.Parallel()
+	prefix := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakSamlClientDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceKeycloakSamlClients_filters(prefix),
+				Check: resource.ComposeTestCheckFunc(
+					// the openid client shares the prefix but is not a saml client
+					resource.TestCheckResourceAttr("data.keycloak_saml_clients.prefix", "clients.#", "2"),
+					resource.TestCheckResourceAttr("data.keycloak_saml_clients.enabled", "clients.#", "1"),
+					resource.TestCheckResourceAttr("data.keycloak_saml_clients.enabled", "clients.0.client_id", prefix+"-enabled"),
+					resource.TestCheckResourceAttr("data.keycloak_saml_clients.enabled", "clients.0.valid_redirect_uris.#", "1"),
+					resource.TestCheckResourceAttr("data.keycloak_saml_clients.attribute", "clients.#", "1"),
+					resource.TestCheckResourceAttr("data.keycloak_saml_clients.attribute", "clients.0.client_id", prefix+"-disabled"),
+				),
+			},
+		},
+	})
+}
+
+func testDataSourceKeycloakSamlClients_filters(prefix string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_saml_client" "enabled" {
+	realm_id            = data.keycloak_realm.realm.id
+	client_id           = "%s-enabled"
+	valid_redirect_uris = ["http://localhost:5555/saml"]
+}
+
+resource "keycloak_saml_client" "disabled" {
+	realm_id  = data.keycloak_realm.realm.id
+	client_id = "%s-disabled"
+	enabled   = false
+
+	extra_config = {
+		"team" = "finance"
+	}
+}
+
+resource "keycloak_openid_client" "openid" {
+	realm_id    = data.keycloak_realm.realm.id
+	client_id   = "%s-openid"
+	access_type = "BEARER-ONLY"
+}
+
+data "keycloak_saml_clients" "prefix" {
+	realm_id         = data.keycloak_realm.realm.id
+	client_id_prefix = "%s-"
+
+	depends_on = [
+		keycloak_saml_client.enabled,
+		keycloak_saml_client.disabled,
+		keycloak_openid_client.openid,
+	]
+}
+
+data "keycloak_saml_clients" "enabled" {
+	realm_id         = data.keycloak_realm.realm.id
+	client_id_prefix = "%s-"
+	enabled          = true
+
+	depends_on = [
+		keycloak_saml_client.enabled,
+		keycloak_saml_client.disabled,
+		keycloak_openid_client.openid,
+	]
+}
+
+data "keycloak_saml_clients" "attribute" {
+	realm_id        = data.keycloak_realm.realm.id
+	client_id_regex = "^%s-"
+
+	attributes = {
+		"team" = "finance"
+	}
+
+	depends_on = [
+		keycloak_saml_client.enabled,
+		keycloak_saml_client.disabled,
+		keycloak_openid_client.openid,
+	]
+}
+	`, testAccRealm.Realm, prefix, prefix, prefix, prefix, prefix, prefix)
+}
@@ -9,7 +9,7 @@ import (
 )
 
 func dataSourceKeycloakUser() *schema.Resource {
-	return &schema.Resource{
+	dataSource := &schema.Resource{
 		ReadContext: dataSourceKeycloakUserRead,
 		Schema: map[string]*schema.Schema{
 			"realm_id": {
@@ -40,6 +40,23 @@ func dataSourceKeycloakUser() *schema.Resource {
 				Type:     schema.TypeMap,
 				Computed: true,
 			},
+			"attribute": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"values": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
 			"required_actions": {
 				Type:     schema.TypeSet,
 				Elem:     &schema.Schema{Type: schema.TypeString},
@@ -56,6 +73,10 @@ func dataSourceKeycloakUser() *schema.Resource {
 			},
 		},
 	}
+
+	dataSource.Schema = mergeSchemas(dataSource.Schema, effectiveRolesSchema())
+
+	return dataSource
 }
 
 func dataSourceKeycloakUserRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -73,6 +94,16 @@ func dataSourceKeycloakUserRead(ctx context.Context, data *schema.ResourceData,
 	}
 
 	mapFromUserToData(data, user)
+	data.Set("attribute", getUserAttributeBlocks(user.Attributes))
+
+	if data.Get("include_effective_roles").(bool) {
+		effectiveRoles, err := keycloakClient.GetUserEffectiveRoles(ctx, realmID, user.Id)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		setEffectiveRolesData(data, effectiveRoles)
+	}
 
 	return nil
 }
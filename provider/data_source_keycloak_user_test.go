@@ -52,6 +52,43 @@ func TestAccKeycloakDataSourceUser_gracefulError(t *testing.T) {
 	})
 }
 
+func TestAccKeycloakDataSourceUser_effectiveRoles(t *testing.T) {
+	t.Parallel()
+	prefix := acctest.RandomWithPrefix("tf-acc")
+	dataSourceName := "data.keycloak_user.user"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakUserDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceKeycloakUser_effectiveRoles(prefix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "effective_roles.*", map[string]string{
+						"name":          prefix + "-composite",
+						"composite":     "true",
+						"source_path.#": "1",
+						"source_path.0": "group:/" + prefix + "-parent",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "effective_roles.*", map[string]string{
+						"name":          prefix + "-client-role",
+						"client_id":     prefix + "-client",
+						"source_path.#": "2",
+						"source_path.0": "group:/" + prefix + "-parent",
+						"source_path.1": "role:" + prefix + "-composite",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "effective_roles.*", map[string]string{
+						"name":          prefix + "-direct",
+						"source_path.#": "1",
+						"source_path.0": "user",
+					}),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckDataKeycloakUser(resourceName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[resourceName]
@@ -115,3 +152,82 @@ data "keycloak_user" "user" {
 }
 	`, testAccRealm.Realm, username)
 }
+
+func testDataSourceKeycloakUser_effectiveRoles(prefix string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_openid_client" "client" {
+	realm_id    = data.keycloak_realm.realm.id
+	client_id   = "%s-client"
+	access_type = "PUBLIC"
+}
+
+resource "keycloak_role" "client_role" {
+	realm_id  = data.keycloak_realm.realm.id
+	client_id = keycloak_openid_client.client.id
+	name      = "%s-client-role"
+}
+
+resource "keycloak_role" "composite" {
+	realm_id        = data.keycloak_realm.realm.id
+	name            = "%s-composite"
+	composite_roles = [keycloak_role.client_role.id]
+}
+
+resource "keycloak_role" "direct" {
+	realm_id = data.keycloak_realm.realm.id
+	name     = "%s-direct"
+}
+
+resource "keycloak_group" "parent" {
+	realm_id = data.keycloak_realm.realm.id
+	name     = "%s-parent"
+}
+
+resource "keycloak_group" "child" {
+	realm_id  = data.keycloak_realm.realm.id
+	parent_id = keycloak_group.parent.id
+	name      = "child"
+}
+
+resource "keycloak_group_roles" "parent" {
+	realm_id = data.keycloak_realm.realm.id
+	group_id = keycloak_group.parent.id
+	role_ids = [keycloak_role.composite.id]
+}
+
+resource "keycloak_user" "user" {
+	realm_id = data.keycloak_realm.realm.id
+	username = "%s-user"
+}
+
+resource "keycloak_user_roles" "user" {
+	realm_id = data.keycloak_realm.realm.id
+	user_id  = keycloak_user.user.id
+	role_ids = [keycloak_role.direct.id]
+
+	exhaustive = false
+}
+
+resource "keycloak_user_groups" "user" {
+	realm_id  = data.keycloak_realm.realm.id
+	user_id   = keycloak_user.user.id
+	group_ids = [keycloak_group.child.id]
+}
+
+data "keycloak_user" "user" {
+	realm_id                = data.keycloak_realm.realm.id
+	username                = keycloak_user.user.username
+	include_effective_roles = true
+
+	depends_on = [
+		keycloak_group_roles.parent,
+		keycloak_user_roles.user,
+		keycloak_user_groups.user,
+	]
+}
+	`, testAccRealm.Realm, prefix, prefix, prefix, prefix, prefix, prefix)
+}
@@ -0,0 +1,193 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+)
+
+func dataSourceKeycloakUsers() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceKeycloakUsersRead,
+		Schema: map[string]*schema.Schema{
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"search": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"username", "email"},
+			},
+			"username": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"email": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"exact": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"idp_alias": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"attributes": {
+				Type:         schema.TypeMap,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				Optional:     true,
+				ValidateFunc: validateUserAttributeQuery,
+			},
+			"federation_link": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"users": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"username": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"email": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"email_verified": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"first_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"enabled": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"federation_link": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"attributes": {
+							Type:     schema.TypeMap,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// validateUserAttributeQuery rejects attributes that cannot be sent in the q parameter of the admin API, which is a
+// space separated list of key:value pairs
+func validateUserAttributeQuery(i interface{}, k string) (s []string, errs []error) {
+	attributes, ok := i.(map[string]interface{})
+	if !ok {
+		errs = append(errs, fmt.Errorf("expected type of %s to be map", k))
+		return
+	}
+
+	for key, value := range attributes {
+		if strings.ContainsAny(key, " :") {
+			errs = append(errs, fmt.Errorf("expected the keys of %s to contain no space or colon, got %q", k, key))
+		}
+		if v, ok := value.(string); ok && strings.ContainsAny(v, " :") {
+			errs = append(errs, fmt.Errorf("expected the value of %s.%s to contain no space or colon, got %q", k, key, v))
+		}
+	}
+
+	return
+}
+
+func dataSourceKeycloakUsersRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+
+	params := map[string]string{}
+	if v, ok := data.GetOk("search"); ok {
+		params["search"] = v.(string)
+	}
+	if v, ok := data.GetOk("username"); ok {
+		params["username"] = v.(string)
+	}
+	if v, ok := data.GetOk("email"); ok {
+		params["email"] = v.(string)
+	}
+	if data.Get("exact").(bool) {
+		params["exact"] = "true"
+	}
+	if v, ok := data.GetOkExists("enabled"); ok {
+		params["enabled"] = strconv.FormatBool(v.(bool))
+	}
+	if v, ok := data.GetOk("idp_alias"); ok {
+		params["idpAlias"] = v.(string)
+	}
+
+	attributes := map[string]string{}
+	for key, value := range data.Get("attributes").(map[string]interface{}) {
+		attributes[key] = value.(string)
+	}
+
+	users, err := keycloakClient.SearchUsers(ctx, realmId, params, attributes)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// the admin API cannot filter on the federation link, so this filter is applied to the results
+	federationLink := data.Get("federation_link").(string)
+
+	var userList []interface{}
+	for _, user := range users {
+		if federationLink != "" && user.FederationLink != federationLink {
+			continue
+		}
+
+		userAttributes := map[string]string{}
+		for k, v := range user.Attributes {
+			userAttributes[k] = strings.Join(v, MULTIVALUE_ATTRIBUTE_SEPARATOR)
+		}
+
+		userList = append(userList, map[string]interface{}{
+			"id":              user.Id,
+			"username":        user.Username,
+			"email":           user.Email,
+			"email_verified":  user.EmailVerified,
+			"first_name":      user.FirstName,
+			"last_name":       user.LastName,
+			"enabled":         user.Enabled,
+			"federation_link": user.FederationLink,
+			"attributes":      userAttributes,
+		})
+	}
+
+	data.Set("users", userList)
+	data.SetId(realmId)
+
+	return nil
+}
@@ -0,0 +1,173 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccKeycloakDataSourceUsers_attributeQuery(t *testing.T) {
+	t.Parallel()
+	prefix := acctest.RandomWithPrefix("tf-acc")
+	department := acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakUserDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceKeycloakUsers_attributeQuery(prefix, department),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.keycloak_users.finance", "users.#", "2"),
+					resource.TestCheckResourceAttr("data.keycloak_users.enabled_finance", "users.#", "1"),
+					resource.TestCheckResourceAttr("data.keycloak_users.enabled_finance", "users.0.username", prefix+"-0"),
+					resource.TestCheckResourceAttr("data.keycloak_users.search", "users.#", "3"),
+					resource.TestCheckResourceAttr("data.keycloak_users.exact", "users.#", "1"),
+					resource.TestCheckResourceAttr("data.keycloak_users.exact", "users.0.email", prefix+"-2@example.com"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakDataSourceUsers_pagination(t *testing.T) {
+	t.Parallel()
+	prefix := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakUserDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceKeycloakUsers_pagination(prefix, 150),
+				Check:  resource.TestCheckResourceAttr("data.keycloak_users.users", "users.#", "150"),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakDataSourceUsers_invalidAttributeQuery(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config:      testDataSourceKeycloakUsers_invalidAttributeQuery("finance:emea"),
+				ExpectError: regexp.MustCompile("to contain no space or colon"),
+			},
+			{
+				Config:      testDataSourceKeycloakUsers_invalidAttributeQuery("finance emea"),
+				ExpectError: regexp.MustCompile("to contain no space or colon"),
+			},
+		},
+	})
+}
+
+func testDataSourceKeycloakUsers_attributeQuery(prefix, department string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_user" "user" {
+	count    = 3
+	realm_id = data.keycloak_realm.realm.id
+	username = "%s-${count.index}"
+	email    = "%s-${count.index}@example.com"
+	enabled  = count.index != 1
+
+	attributes = {
+		department = count.index < 2 ? "%s" : "sales"
+	}
+}
+
+data "keycloak_users" "finance" {
+	realm_id = data.keycloak_realm.realm.id
+
+	attributes = {
+		department = "%s"
+	}
+
+	depends_on = [
+		keycloak_user.user,
+	]
+}
+
+data "keycloak_users" "enabled_finance" {
+	realm_id = data.keycloak_realm.realm.id
+	enabled  = true
+
+	attributes = {
+		department = "%s"
+	}
+
+	depends_on = [
+		keycloak_user.user,
+	]
+}
+
+data "keycloak_users" "search" {
+	realm_id = data.keycloak_realm.realm.id
+	search   = "%s"
+
+	depends_on = [
+		keycloak_user.user,
+	]
+}
+
+data "keycloak_users" "exact" {
+	realm_id = data.keycloak_realm.realm.id
+	email    = "%s-2@example.com"
+	exact    = true
+
+	depends_on = [
+		keycloak_user.user,
+	]
+}
+	`, testAccRealm.Realm, prefix, prefix, department, department, department, prefix, prefix)
+}
+
+func testDataSourceKeycloakUsers_pagination(prefix string, count int) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_user" "user" {
+	count    = %d
+	realm_id = data.keycloak_realm.realm.id
+	username = "%s-${count.index}"
+}
+
+data "keycloak_users" "users" {
+	realm_id = data.keycloak_realm.realm.id
+	search   = "%s"
+
+	depends_on = [
+		keycloak_user.user,
+	]
+}
+	`, testAccRealm.Realm, count, prefix, prefix)
+}
+
+func testDataSourceKeycloakUsers_invalidAttributeQuery(department string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+data "keycloak_users" "users" {
+	realm_id   = data.keycloak_realm.realm.id
+	attributes = {
+		department = "%s"
+	}
+}
+	`, testAccRealm.Realm, department)
+}
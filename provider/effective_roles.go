@@ -0,0 +1,61 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+)
+
+// the include_effective_roles and effective_roles attributes shared by the user, group and service account data sources
+func effectiveRolesSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"include_effective_roles": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+		"effective_roles": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"id": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"name": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"client_id": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"composite": {
+						Type:     schema.TypeBool,
+						Computed: true,
+					},
+					"source_path": {
+						Type:     schema.TypeList,
+						Elem:     &schema.Schema{Type: schema.TypeString},
+						Computed: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func setEffectiveRolesData(data *schema.ResourceData, effectiveRoles []*keycloak.EffectiveRole) {
+	var roles []interface{}
+	for _, effectiveRole := range effectiveRoles {
+		roles = append(roles, map[string]interface{}{
+			"id":          effectiveRole.Id,
+			"name":        effectiveRole.Name,
+			"client_id":   effectiveRole.ClientId,
+			"composite":   effectiveRole.Composite,
+			"source_path": effectiveRole.SourcePath,
+		})
+	}
+
+	data.Set("effective_roles", roles)
+}
@@ -34,6 +34,49 @@ func getExtraConfigFromData(data *schema.ResourceData) map[string]interface{} {
 	return extraConfig
 }
 
+// setRemovedAttributesToEmpty sets the attributes of arguments whose value was removed to an empty string within extraConfig.
+// empty attributes tagged with omitempty are not sent, and Keycloak keeps the attributes of a client that are missing from
+// an update, so they have to be set to an empty string explicitly in order to remove them on the Keycloak side
+func setRemovedAttributesToEmpty(data *schema.ResourceData, extraConfig map[string]interface{}, attributes map[string][]string) {
+	if data.IsNewResource() {
+		return
+	}
+
+	for key, attributeNames := range attributes {
+		if !data.HasChange(key) {
+			continue
+		}
+
+		oldValue, newValue := data.GetChange(key)
+		if isEmptyAttributeValue(oldValue) || !isEmptyAttributeValue(newValue) {
+			continue
+		}
+
+		for _, attributeName := range attributeNames {
+			extraConfig[attributeName] = ""
+		}
+	}
+}
+
+func isEmptyAttributeValue(value interface{}) bool {
+	switch v := value.(type) {
+	case nil:
+		return true
+	case string:
+		return v == ""
+	case bool:
+		return !v
+	case []interface{}:
+		return len(v) == 0
+	case map[string]interface{}:
+		return len(v) == 0
+	case *schema.Set:
+		return v.Len() == 0
+	}
+
+	return false
+}
+
 func setExtraConfigData(data *schema.ResourceData, extraConfig map[string]interface{}) {
 	newExtraConfig := map[string]interface{}{}
 	extraConfigFromState := getExtraConfigFromData(data)
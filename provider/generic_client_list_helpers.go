@@ -0,0 +1,88 @@
+package provider
+
+import (
+	"context"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+)
+
+// clientListFilterSchema returns the arguments shared by the data sources that list the clients of a realm
+func clientListFilterSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"realm_id": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"client_id_prefix": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"client_id_regex": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsValidRegExp,
+		},
+		"enabled": {
+			Type:     schema.TypeBool,
+			Optional: true,
+		},
+		"attributes": {
+			Type:     schema.TypeMap,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+			Optional: true,
+		},
+	}
+}
+
+// getFilteredGenericClients lists the clients of a realm that use the given protocol and match the filters of clientListFilterSchema
+func getFilteredGenericClients(ctx context.Context, keycloakClient *keycloak.KeycloakClient, data *schema.ResourceData, protocol string) ([]*keycloak.GenericClient, error) {
+	clients, err := keycloakClient.ListGenericClients(ctx, data.Get("realm_id").(string))
+	if err != nil {
+		return nil, err
+	}
+
+	clientIdPrefix := data.Get("client_id_prefix").(string)
+	attributes := data.Get("attributes").(map[string]interface{})
+
+	var clientIdRegex *regexp.Regexp
+	if v, ok := data.GetOk("client_id_regex"); ok {
+		clientIdRegex = regexp.MustCompile(v.(string))
+	}
+
+	enabled, filterEnabled := data.GetOkExists("enabled")
+
+	var filteredClients []*keycloak.GenericClient
+	for _, client := range clients {
+		if client.Protocol != protocol {
+			continue
+		}
+		if !strings.HasPrefix(client.ClientId, clientIdPrefix) {
+			continue
+		}
+		if clientIdRegex != nil && !clientIdRegex.MatchString(client.ClientId) {
+			continue
+		}
+		if filterEnabled && client.Enabled != enabled.(bool) {
+			continue
+		}
+
+		matchesAttributes := true
+		for key, value := range attributes {
+			if client.Attributes[key] != value.(string) {
+				matchesAttributes = false
+				break
+			}
+		}
+		if !matchesAttributes {
+			continue
+		}
+
+		filteredClients = append(filteredClients, client)
+	}
+
+	return filteredClients, nil
+}
@@ -0,0 +1,46 @@
+package provider
+
+import (
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+)
+
+// The ownership tag is stored alongside other attributes of realms, clients and groups. It is written on create and
+// update, and is never exposed through the attributes / extra_config arguments of those resources.
+
+func getOwnerFromAttributes(attributes map[string]interface{}) string {
+	if owner, ok := attributes[keycloak.OwnershipTagAttribute].(string); ok {
+		return owner
+	}
+
+	return ""
+}
+
+func setOwnershipTagAttribute(keycloakClient *keycloak.KeycloakClient, attributes map[string]interface{}) {
+	if ownershipTag := keycloakClient.OwnershipTag(); ownershipTag != "" {
+		attributes[keycloak.OwnershipTagAttribute] = ownershipTag
+	}
+}
+
+func getOwnerFromGroupAttributes(attributes map[string][]string) string {
+	if owner, ok := attributes[keycloak.OwnershipTagAttribute]; ok && len(owner) != 0 {
+		return owner[0]
+	}
+
+	return ""
+}
+
+func setOwnershipTagGroupAttribute(keycloakClient *keycloak.KeycloakClient, attributes map[string][]string) {
+	if ownershipTag := keycloakClient.OwnershipTag(); ownershipTag != "" {
+		attributes[keycloak.OwnershipTagAttribute] = []string{ownershipTag}
+	}
+}
+
+func getOwnerFromStringAttributes(attributes map[string]string) string {
+	return attributes[keycloak.OwnershipTagAttribute]
+}
+
+func setOwnershipTagStringAttribute(keycloakClient *keycloak.KeycloakClient, attributes map[string]string) {
+	if ownershipTag := keycloakClient.OwnershipTag(); ownershipTag != "" {
+		attributes[keycloak.OwnershipTagAttribute] = ownershipTag
+	}
+}
@@ -13,24 +13,32 @@ import (
 func KeycloakProvider(client *keycloak.KeycloakClient) *schema.Provider {
 	provider := &schema.Provider{
 		DataSourcesMap: map[string]*schema.Resource{
-			"keycloak_group":                              dataSourceKeycloakGroup(),
-			"keycloak_openid_client":                      dataSourceKeycloakOpenidClient(),
-			"keycloak_openid_client_authorization_policy": dataSourceKeycloakOpenidClientAuthorizationPolicy(),
-			"keycloak_openid_client_scope":                dataSourceKeycloakOpenidClientScope(),
-			"keycloak_openid_client_service_account_user": dataSourceKeycloakOpenidClientServiceAccountUser(),
-			"keycloak_realm":                              dataSourceKeycloakRealm(),
-			"keycloak_realm_keys":                         dataSourceKeycloakRealmKeys(),
-			"keycloak_role":                               dataSourceKeycloakRole(),
-			"keycloak_user":                               dataSourceKeycloakUser(),
-			"keycloak_user_realm_roles":                   dataSourceKeycloakUserRealmRoles(),
-			"keycloak_saml_client_installation_provider":  dataSourceKeycloakSamlClientInstallationProvider(),
-			"keycloak_saml_client":                        dataSourceKeycloakSamlClient(),
-			"keycloak_authentication_execution":           dataSourceKeycloakAuthenticationExecution(),
-			"keycloak_authentication_flow":                dataSourceKeycloakAuthenticationFlow(),
-			"keycloak_client_description_converter":       dataSourceKeycloakClientDescriptionConverter(),
+			"keycloak_group":                               dataSourceKeycloakGroup(),
+			"keycloak_groups":                              dataSourceKeycloakGroups(),
+			"keycloak_openid_client":                       dataSourceKeycloakOpenidClient(),
+			"keycloak_openid_client_authorization_policy":  dataSourceKeycloakOpenidClientAuthorizationPolicy(),
+			"keycloak_openid_client_installation_provider": dataSourceKeycloakOpenidClientInstallationProvider(),
+			"keycloak_openid_client_scope":                 dataSourceKeycloakOpenidClientScope(),
+			"keycloak_openid_client_service_account_user":  dataSourceKeycloakOpenidClientServiceAccountUser(),
+			"keycloak_openid_clients":                      dataSourceKeycloakOpenidClients(),
+			"keycloak_realm":                               dataSourceKeycloakRealm(),
+			"keycloak_realm_keys":                          dataSourceKeycloakRealmKeys(),
+			"keycloak_role":                                dataSourceKeycloakRole(),
+			"keycloak_roles":                               dataSourceKeycloakRoles(),
+			"keycloak_user":                                dataSourceKeycloakUser(),
+			"keycloak_user_realm_roles":                    dataSourceKeycloakUserRealmRoles(),
+			"keycloak_user_credentials":                    dataSourceKeycloakUserCredentials(),
+			"keycloak_users":                               dataSourceKeycloakUsers(),
+			"keycloak_saml_client_installation_provider":   dataSourceKeycloakSamlClientInstallationProvider(),
+			"keycloak_saml_client":                         dataSourceKeycloakSamlClient(),
+			"keycloak_saml_clients":                        dataSourceKeycloakSamlClients(),
+			"keycloak_authentication_execution":            dataSourceKeycloakAuthenticationExecution(),
+			"keycloak_authentication_flow":                 dataSourceKeycloakAuthenticationFlow(),
+			"keycloak_client_description_converter":        dataSourceKeycloakClientDescriptionConverter(),
 		},
 		ResourcesMap: map[string]*schema.Resource{
 			"keycloak_realm":                                             resourceKeycloakRealm(),
+			"keycloak_realm_attributes":                                  resourceKeycloakRealmAttributes(),
 			"keycloak_realm_events":                                      resourceKeycloakRealmEvents(),
 			"keycloak_realm_keystore_aes_generated":                      resourceKeycloakRealmKeystoreAesGenerated(),
 			"keycloak_realm_keystore_ecdsa_generated":                    resourceKeycloakRealmKeystoreEcdsaGenerated(),
@@ -38,6 +46,7 @@ func KeycloakProvider(client *keycloak.KeycloakClient) *schema.Provider {
 			"keycloak_realm_keystore_java_keystore":                      resourceKeycloakRealmKeystoreJavaKeystore(),
 			"keycloak_realm_keystore_rsa":                                resourceKeycloakRealmKeystoreRsa(),
 			"keycloak_realm_keystore_rsa_generated":                      resourceKeycloakRealmKeystoreRsaGenerated(),
+			"keycloak_realm_smtp_server":                                 resourceKeycloakRealmSmtpServer(),
 			"keycloak_realm_user_profile":                                resourceKeycloakRealmUserProfile(),
 			"keycloak_required_action":                                   resourceKeycloakRequiredAction(),
 			"keycloak_group":                                             resourceKeycloakGroup(),
@@ -47,7 +56,12 @@ func KeycloakProvider(client *keycloak.KeycloakClient) *schema.Provider {
 			"keycloak_group_roles":                                       resourceKeycloakGroupRoles(),
 			"keycloak_user":                                              resourceKeycloakUser(),
 			"keycloak_user_roles":                                        resourceKeycloakUserRoles(),
+			"keycloak_user_password":                                     resourceKeycloakUserPassword(),
+			"keycloak_user_federated_identity":                           resourceKeycloakUserFederatedIdentity(),
+			"keycloak_users_bulk":                                        resourceKeycloakUsersBulk(),
+			"keycloak_client":                                            resourceKeycloakClient(),
 			"keycloak_openid_client":                                     resourceKeycloakOpenidClient(),
+			"keycloak_openid_client_secret_rotation":                     resourceKeycloakOpenidClientSecretRotation(),
 			"keycloak_openid_client_scope":                               resourceKeycloakOpenidClientScope(),
 			"keycloak_ldap_user_federation":                              resourceKeycloakLdapUserFederation(),
 			"keycloak_ldap_user_attribute_mapper":                        resourceKeycloakLdapUserAttributeMapper(),
@@ -76,6 +90,7 @@ func KeycloakProvider(client *keycloak.KeycloakClient) *schema.Provider {
 			"keycloak_openid_client_default_scopes":                      resourceKeycloakOpenidClientDefaultScopes(),
 			"keycloak_openid_client_optional_scopes":                     resourceKeycloakOpenidClientOptionalScopes(),
 			"keycloak_saml_client":                                       resourceKeycloakSamlClient(),
+			"keycloak_saml_client_key":                                   resourceKeycloakSamlClientKey(),
 			"keycloak_saml_client_scope":                                 resourceKeycloakSamlClientScope(),
 			"keycloak_saml_client_default_scopes":                        resourceKeycloakSamlClientDefaultScopes(),
 			"keycloak_generic_client_protocol_mapper":                    resourceKeycloakGenericClientProtocolMapper(),
@@ -113,6 +128,8 @@ func KeycloakProvider(client *keycloak.KeycloakClient) *schema.Provider {
 			"keycloak_authentication_execution_config":                   resourceKeycloakAuthenticationExecutionConfig(),
 			"keycloak_identity_provider_token_exchange_scope_permission": resourceKeycloakIdentityProviderTokenExchangeScopePermission(),
 			"keycloak_openid_client_permissions":                         resourceKeycloakOpenidClientPermissions(),
+			"keycloak_saml_client_permissions":                           resourceKeycloakSamlClientPermissions(),
+			"keycloak_realm_admin_permission":                            resourceKeycloakRealmAdminPermission(),
 			"keycloak_users_permissions":                                 resourceKeycloakUsersPermissions(),
 			"keycloak_user_groups":                                       resourceKeycloakUserGroups(),
 			"keycloak_group_permissions":                                 resourceKeycloakGroupPermissions(),
@@ -192,6 +209,12 @@ func KeycloakProvider(client *keycloak.KeycloakClient) *schema.Provider {
 					Type: schema.TypeString,
 				},
 			},
+			"ownership_tag": {
+				Optional:    true,
+				Type:        schema.TypeString,
+				Description: "When set, realms, clients and groups created by this provider are marked with this tag, and objects marked with a different tag are never adopted, modified or deleted.",
+				DefaultFunc: schema.EnvDefaultFunc("KEYCLOAK_OWNERSHIP_TAG", ""),
+			},
 		},
 	}
 
@@ -216,12 +239,13 @@ func KeycloakProvider(client *keycloak.KeycloakClient) *schema.Provider {
 		for k, v := range data.Get("additional_headers").(map[string]interface{}) {
 			additionalHeaders[k] = v.(string)
 		}
+		ownershipTag := data.Get("ownership_tag").(string)
 
 		var diags diag.Diagnostics
 
 		userAgent := fmt.Sprintf("HashiCorp Terraform/%s (+https://www.terraform.io) Terraform Plugin SDK/%s", provider.TerraformVersion, meta.SDKVersionString())
 
-		keycloakClient, err := keycloak.NewKeycloakClient(ctx, url, basePath, clientId, clientSecret, realm, username, password, initialLogin, clientTimeout, rootCaCertificate, tlsInsecureSkipVerify, userAgent, redHatSSO, additionalHeaders)
+		keycloakClient, err := keycloak.NewKeycloakClient(ctx, url, basePath, clientId, clientSecret, realm, username, password, initialLogin, clientTimeout, rootCaCertificate, tlsInsecureSkipVerify, userAgent, redHatSSO, additionalHeaders, ownershipTag)
 		if err != nil {
 			diags = append(diags, diag.Diagnostic{
 				Severity: diag.Error,
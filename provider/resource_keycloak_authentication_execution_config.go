@@ -72,6 +72,11 @@ func resourceKeycloakAuthenticationExecutionConfigCreate(ctx context.Context, da
 
 	config := getAuthenticationExecutionConfigFromData(data)
 
+	err := keycloakClient.ValidateAuthenticationExecutionConfig(ctx, config)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	id, err := keycloakClient.NewAuthenticationExecutionConfig(ctx, config)
 	if err != nil {
 		return diag.FromErr(err)
@@ -106,7 +111,12 @@ func resourceKeycloakAuthenticationExecutionConfigUpdate(ctx context.Context, da
 
 	config := getAuthenticationExecutionConfigFromData(data)
 
-	err := keycloakClient.UpdateAuthenticationExecutionConfig(ctx, config)
+	err := keycloakClient.ValidateAuthenticationExecutionConfig(ctx, config)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = keycloakClient.UpdateAuthenticationExecutionConfig(ctx, config)
 	if err != nil {
 		return diag.FromErr(err)
 	}
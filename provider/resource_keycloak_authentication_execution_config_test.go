@@ -3,6 +3,7 @@ package provider
 import (
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -115,6 +116,30 @@ func TestAccKeycloakAuthenticationExecutionConfig_import(t *testing.T) {
 	})
 }
 
+func TestAccKeycloakAuthenticationExecutionConfig_levelOfAuthentication(t *testing.T) {
+	skipIfVersionIsLessThanOrEqualTo(testCtx, t, keycloakClient, keycloak.Version_16)
+
+	realmName := acctest.RandomWithPrefix("tf-acc")
+	flowAlias := acctest.RandomWithPrefix("tf-acc")
+	configAlias := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakAuthenticationExecutionConfigDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccKeycloakAuthenticationExecutionConfig_levelOfAuthentication(realmName, flowAlias, configAlias, 3),
+				ExpectError: regexp.MustCompile("validation error: level of authentication 3 is not mapped to any ACR value"),
+			},
+			{
+				Config: testAccKeycloakAuthenticationExecutionConfig_levelOfAuthentication(realmName, flowAlias, configAlias, 2),
+				Check:  resource.TestCheckResourceAttr("keycloak_authentication_execution_config.config", "config.loa-condition-level", "2"),
+			},
+		},
+	})
+}
+
 func getExecutionConfigImportId(resourceName string) resource.ImportStateIdFunc {
 	return func(s *terraform.State) (string, error) {
 		rs, ok := s.RootModule().Resources[resourceName]
@@ -210,3 +235,36 @@ resource "keycloak_authentication_execution_config" "config" {
 	}
 }`, testAccRealm.Realm, flowAlias, configAlias, configProvider)
 }
+
+func testAccKeycloakAuthenticationExecutionConfig_levelOfAuthentication(realm, flowAlias, configAlias string, level int) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+
+	acr_loa_mapping = {
+		silver = 1
+		gold   = 2
+	}
+}
+
+resource "keycloak_authentication_flow" "flow" {
+	realm_id = keycloak_realm.realm.id
+	alias    = "%s"
+}
+
+resource "keycloak_authentication_execution" "execution" {
+	realm_id          = keycloak_realm.realm.id
+	parent_flow_alias = keycloak_authentication_flow.flow.alias
+	authenticator     = "conditional-level-of-authentication"
+}
+
+resource "keycloak_authentication_execution_config" "config" {
+	realm_id     = keycloak_realm.realm.id
+	execution_id = keycloak_authentication_execution.execution.id
+	alias        = "%s"
+	config = {
+		loa-condition-level = "%d"
+		loa-max-age         = "36000"
+	}
+}`, realm, flowAlias, configAlias, level)
+}
@@ -0,0 +1,276 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+)
+
+func resourceKeycloakClient() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceKeycloakClientCreate,
+		ReadContext:   resourceKeycloakClientRead,
+		DeleteContext: resourceKeycloakClientDelete,
+		UpdateContext: resourceKeycloakClientUpdate,
+		// This resource can be imported using {{realm}}/{{client_keycloak_id}}
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceKeycloakClientImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"client_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"protocol": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				// these protocols have their own resources, which know about the attributes they use
+				ValidateFunc: validation.All(
+					validation.StringIsNotEmpty,
+					validation.StringNotInSlice([]string{"openid-connect", "saml"}, false),
+				),
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"root_url": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"base_url": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"admin_url": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"valid_redirect_uris": {
+				Type:     schema.TypeSet,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+				Optional: true,
+			},
+			"web_origins": {
+				Type:     schema.TypeSet,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+				Optional: true,
+			},
+			"full_scope_allowed": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"attributes": {
+				Type:     schema.TypeMap,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Optional: true,
+			},
+		},
+	}
+}
+
+// getClientAttributesFromData returns the attributes of the client. Like extra_config, attributes that were removed from the
+// configuration are sent as empty strings, since Keycloak keeps the attributes that are left out of an update.
+func getClientAttributesFromData(data *schema.ResourceData) map[string]string {
+	attributes := map[string]string{}
+
+	for key, value := range data.Get("attributes").(map[string]interface{}) {
+		attributes[key] = value.(string)
+	}
+
+	if !data.IsNewResource() && data.HasChange("attributes") {
+		oldAttributes, _ := data.GetChange("attributes")
+		for key := range oldAttributes.(map[string]interface{}) {
+			if _, ok := attributes[key]; !ok {
+				attributes[key] = ""
+			}
+		}
+	}
+
+	return attributes
+}
+
+func mapToGenericClientFromData(data *schema.ResourceData) *keycloak.GenericClient {
+	return &keycloak.GenericClient{
+		Id:                data.Id(),
+		ClientId:          data.Get("client_id").(string),
+		RealmId:           data.Get("realm_id").(string),
+		Protocol:          data.Get("protocol").(string),
+		Name:              data.Get("name").(string),
+		Description:       data.Get("description").(string),
+		Enabled:           data.Get("enabled").(bool),
+		RootUrl:           data.Get("root_url").(string),
+		BaseUrl:           data.Get("base_url").(string),
+		AdminUrl:          data.Get("admin_url").(string),
+		ValidRedirectUris: interfaceSliceToStringSlice(data.Get("valid_redirect_uris").(*schema.Set).List()),
+		WebOrigins:        interfaceSliceToStringSlice(data.Get("web_origins").(*schema.Set).List()),
+		FullScopeAllowed:  data.Get("full_scope_allowed").(bool),
+		Attributes:        getClientAttributesFromData(data),
+	}
+}
+
+func mapToDataFromGenericClient(data *schema.ResourceData, client *keycloak.GenericClient) {
+	data.SetId(client.Id)
+
+	data.Set("client_id", client.ClientId)
+	data.Set("realm_id", client.RealmId)
+	data.Set("protocol", client.Protocol)
+	data.Set("name", client.Name)
+	data.Set("description", client.Description)
+	data.Set("enabled", client.Enabled)
+	data.Set("root_url", client.RootUrl)
+	data.Set("base_url", client.BaseUrl)
+	data.Set("admin_url", client.AdminUrl)
+	data.Set("valid_redirect_uris", client.ValidRedirectUris)
+	data.Set("web_origins", client.WebOrigins)
+	data.Set("full_scope_allowed", client.FullScopeAllowed)
+
+	// the login protocol may add attributes of its own, so only the attributes that were configured are kept
+	configuredAttributes := data.Get("attributes").(map[string]interface{})
+	attributes := map[string]string{}
+	for key, value := range client.Attributes {
+		if _, ok := configuredAttributes[key]; ok && value != "" {
+			attributes[key] = value
+		}
+	}
+
+	data.Set("attributes", attributes)
+}
+
+func resourceKeycloakClientCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	client := mapToGenericClientFromData(data)
+
+	setOwnershipTagStringAttribute(keycloakClient, client.Attributes)
+
+	err := keycloakClient.ValidateGenericClient(ctx, client)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = keycloakClient.NewGenericClient(ctx, client)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data.SetId(client.Id)
+
+	return resourceKeycloakClientRead(ctx, data, meta)
+}
+
+func resourceKeycloakClientRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	client, err := keycloakClient.GetGenericClient(ctx, data.Get("realm_id").(string), data.Id())
+	if err != nil {
+		return handleNotFoundError(ctx, err, data)
+	}
+
+	err = keycloakClient.ValidateOwnership(fmt.Sprintf("client %s", client.ClientId), getOwnerFromStringAttributes(client.Attributes))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	mapToDataFromGenericClient(data, client)
+
+	return nil
+}
+
+func resourceKeycloakClientUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	client := mapToGenericClientFromData(data)
+
+	setOwnershipTagStringAttribute(keycloakClient, client.Attributes)
+
+	err := keycloakClient.ValidateGenericClient(ctx, client)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = keycloakClient.UpdateGenericClient(ctx, client)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceKeycloakClientRead(ctx, data, meta)
+}
+
+func resourceKeycloakClientDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	id := data.Id()
+
+	client, err := keycloakClient.GetGenericClient(ctx, realmId, id)
+	if err != nil {
+		if keycloak.ErrorIs404(err) {
+			return nil
+		}
+
+		return diag.FromErr(err)
+	}
+
+	err = keycloakClient.ValidateOwnership(fmt.Sprintf("client %s", client.ClientId), getOwnerFromStringAttributes(client.Attributes))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diag.FromErr(keycloakClient.DeleteGenericClient(ctx, realmId, id))
+}
+
+func resourceKeycloakClientImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("Invalid import. Supported import formats: {{realmId}}/{{clientId}}")
+	}
+
+	client, err := keycloakClient.GetGenericClient(ctx, parts[0], parts[1])
+	if err != nil {
+		return nil, err
+	}
+
+	if client.Protocol == "openid-connect" || client.Protocol == "saml" {
+		return nil, fmt.Errorf("client %s uses the %s protocol and should be imported as a keycloak_openid_client or keycloak_saml_client", client.ClientId, client.Protocol)
+	}
+
+	d.Set("realm_id", parts[0])
+	d.SetId(parts[1])
+
+	// like extra_config, attributes are not imported. the login protocol may add attributes of its own, so only the
+	// attributes that are added to the configuration after the import are managed
+
+	diagnostics := resourceKeycloakClientRead(ctx, d, meta)
+	if diagnostics.HasError() {
+		return nil, errors.New(diagnostics[0].Summary)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,183 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccKeycloakClient_dockerV2(t *testing.T) {
+	skipIfLoginProtocolIsNotInstalled(t, "docker-v2")
+	t.Parallel()
+
+	clientId := acctest.RandomWithPrefix("tf-acc")
+	roleName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakClientDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakClient_dockerV2(clientId, roleName, map[string]string{"registry": "registry.example.com", "team": "platform"}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakClientAttributes("keycloak_client.client", map[string]string{"registry": "registry.example.com", "team": "platform"}),
+					resource.TestCheckResourceAttr("keycloak_client.client", "protocol", "docker-v2"),
+					resource.TestCheckResourceAttrPair("keycloak_generic_protocol_mapper.allow_all", "client_id", "keycloak_client.client", "id"),
+					resource.TestCheckResourceAttrPair("keycloak_generic_role_mapper.role", "client_id", "keycloak_client.client", "id"),
+				),
+			},
+			{
+				Config: testKeycloakClient_dockerV2(clientId, roleName, map[string]string{"registry": "registry.example.com"}),
+				Check:  testAccCheckKeycloakClientAttributes("keycloak_client.client", map[string]string{"registry": "registry.example.com", "team": ""}),
+			},
+			{
+				Config: testKeycloakClient_dockerV2(clientId, roleName, map[string]string{}),
+				Check:  testAccCheckKeycloakClientAttributes("keycloak_client.client", map[string]string{"registry": "", "team": ""}),
+			},
+			{
+				ResourceName:      "keycloak_client.client",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					return testAccRealm.Realm + "/" + s.RootModule().Resources["keycloak_client.client"].Primary.ID, nil
+				},
+			},
+		},
+	})
+}
+
+func TestAccKeycloakClient_unknownProtocol(t *testing.T) {
+	t.Parallel()
+
+	clientId := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakClientDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config:      testKeycloakClient_protocol(clientId, "tf-acc-unknown"),
+				ExpectError: regexp.MustCompile(`login protocol "tf-acc-unknown" does not exist on the server`),
+			},
+			{
+				Config:      testKeycloakClient_protocol(clientId, "openid-connect"),
+				ExpectError: regexp.MustCompile(`expected protocol to not be any of \[openid-connect saml\]`),
+			},
+		},
+	})
+}
+
+func skipIfLoginProtocolIsNotInstalled(t *testing.T, protocol string) {
+	serverInfo, err := keycloakClient.GetServerInfo(testCtx)
+	if err != nil {
+		t.Fatalf("error fetching server info: %v", err)
+	}
+
+	if _, ok := serverInfo.ProviderTypes["login-protocol"].Providers[protocol]; !ok {
+		t.Skipf("login protocol %s is not installed, skipping...", protocol)
+	}
+}
+
+func testAccCheckKeycloakClientAttributes(resourceName string, attributes map[string]string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		client, err := keycloakClient.GetGenericClient(testCtx, rs.Primary.Attributes["realm_id"], rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		for key, value := range attributes {
+			if client.Attributes[key] != value {
+				return fmt.Errorf("expected client %s to have attribute %s with value %q, got %q", client.ClientId, key, value, client.Attributes[key])
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckKeycloakClientDestroy() resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "keycloak_client" {
+				continue
+			}
+
+			client, _ := keycloakClient.GetGenericClient(testCtx, rs.Primary.Attributes["realm_id"], rs.Primary.ID)
+			if client != nil {
+				return fmt.Errorf("client %s still exists", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testKeycloakClient_dockerV2(clientId, roleName string, attributes map[string]string) string {
+	var sb strings.Builder
+	sb.WriteString("{\n")
+	for k, v := range attributes {
+		sb.WriteString(fmt.Sprintf("\t\t\"%s\" = \"%s\"\n", k, v))
+	}
+	sb.WriteString("\t}")
+
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_client" "client" {
+	realm_id    = data.keycloak_realm.realm.id
+	client_id   = "%s"
+	protocol    = "docker-v2"
+	name        = "docker registry"
+
+	attributes = %s
+}
+
+resource "keycloak_generic_protocol_mapper" "allow_all" {
+	realm_id        = data.keycloak_realm.realm.id
+	client_id       = keycloak_client.client.id
+	name            = "allow all"
+	protocol        = "docker-v2"
+	protocol_mapper = "docker-v2-allow-all-mapper"
+	config          = {}
+}
+
+resource "keycloak_role" "role" {
+	realm_id = data.keycloak_realm.realm.id
+	name     = "%s"
+}
+
+resource "keycloak_generic_role_mapper" "role" {
+	realm_id  = data.keycloak_realm.realm.id
+	client_id = keycloak_client.client.id
+	role_id   = keycloak_role.role.id
+}
+	`, testAccRealm.Realm, clientId, sb.String(), roleName)
+}
+
+func testKeycloakClient_protocol(clientId, protocol string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_client" "client" {
+	realm_id  = data.keycloak_realm.realm.id
+	client_id = "%s"
+	protocol  = "%s"
+}
+	`, testAccRealm.Realm, clientId, protocol)
+}
@@ -53,8 +53,8 @@ func resourceKeycloakGenericProtocolMapper() *schema.Resource {
 				Type:         schema.TypeString,
 				Required:     true,
 				ForceNew:     true,
-				Description:  "The protocol of the client (openid-connect / saml).",
-				ValidateFunc: validation.StringInSlice([]string{"openid-connect", "saml"}, false),
+				Description:  "The protocol of the client (openid-connect / saml, or another login protocol installed on the server).",
+				ValidateFunc: validation.StringIsNotEmpty,
 			},
 			"protocol_mapper": {
 				Type:        schema.TypeString,
@@ -70,6 +70,9 @@ func mapFromDataToGroup(data *schema.ResourceData) *keycloak.Group {
 func mapFromGroupToData(data *schema.ResourceData, group *keycloak.Group) {
 	attributes := map[string]string{}
 	for k, v := range group.Attributes {
+		if k == keycloak.OwnershipTagAttribute {
+			continue
+		}
 		attributes[k] = strings.Join(v, MULTIVALUE_ATTRIBUTE_SEPARATOR)
 	}
 	data.SetId(group.Id)
@@ -87,6 +90,8 @@ func resourceKeycloakGroupCreate(ctx context.Context, data *schema.ResourceData,
 
 	group := mapFromDataToGroup(data)
 
+	setOwnershipTagGroupAttribute(keycloakClient, group.Attributes)
+
 	err := keycloakClient.NewGroup(ctx, group)
 	if err != nil {
 		return diag.FromErr(err)
@@ -108,6 +113,11 @@ func resourceKeycloakGroupRead(ctx context.Context, data *schema.ResourceData, m
 		return handleNotFoundError(ctx, err, data)
 	}
 
+	err = keycloakClient.ValidateOwnership(fmt.Sprintf("group %s", group.Path), getOwnerFromGroupAttributes(group.Attributes))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	mapFromGroupToData(data, group)
 
 	return nil
@@ -118,6 +128,8 @@ func resourceKeycloakGroupUpdate(ctx context.Context, data *schema.ResourceData,
 
 	group := mapFromDataToGroup(data)
 
+	setOwnershipTagGroupAttribute(keycloakClient, group.Attributes)
+
 	err := keycloakClient.UpdateGroup(ctx, group)
 	if err != nil {
 		return diag.FromErr(err)
@@ -134,6 +146,20 @@ func resourceKeycloakGroupDelete(ctx context.Context, data *schema.ResourceData,
 	realmId := data.Get("realm_id").(string)
 	id := data.Id()
 
+	group, err := keycloakClient.GetGroup(ctx, realmId, id)
+	if err != nil {
+		if keycloak.ErrorIs404(err) {
+			return nil
+		}
+
+		return diag.FromErr(err)
+	}
+
+	err = keycloakClient.ValidateOwnership(fmt.Sprintf("group %s", group.Path), getOwnerFromGroupAttributes(group.Attributes))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	return diag.FromErr(keycloakClient.DeleteGroup(ctx, realmId, id))
 }
 
@@ -2,7 +2,10 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -15,6 +18,10 @@ func resourceKeycloakGroupMemberships() *schema.Resource {
 		ReadContext:   resourceKeycloakGroupMembershipsRead,
 		DeleteContext: resourceKeycloakGroupMembershipsDelete,
 		UpdateContext: resourceKeycloakGroupMembershipsUpdate,
+		// This resource can be imported using {{realm}}/{{groupId}}.
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceKeycloakGroupMembershipsImport,
+		},
 		Schema: map[string]*schema.Schema{
 			"realm_id": {
 				Type:     schema.TypeString,
@@ -32,10 +39,24 @@ func resourceKeycloakGroupMemberships() *schema.Resource {
 				Set:      schema.HashString,
 				Required: true,
 			},
+			"exhaustive": {
+				Type:     schema.TypeBool,
+				Default:  true,
+				Optional: true,
+			},
 		},
 	}
 }
 
+// members can be referred to by username or by id, and are reported the same way they were configured
+func groupMemberReference(user *keycloak.User, tfMembers *schema.Set) (string, bool) {
+	if tfMembers.Contains(user.Id) {
+		return user.Id, true
+	}
+
+	return user.Username, tfMembers.Contains(user.Username)
+}
+
 func resourceKeycloakGroupMembershipsCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
@@ -63,6 +84,8 @@ func resourceKeycloakGroupMembershipsRead(ctx context.Context, data *schema.Reso
 
 	realmId := data.Get("realm_id").(string)
 	groupId := data.Get("group_id").(string)
+	tfMembers := data.Get("members").(*schema.Set)
+	exhaustive := data.Get("exhaustive").(bool)
 
 	usersInGroup, err := keycloakClient.GetGroupMembers(ctx, realmId, groupId)
 	if err != nil {
@@ -71,7 +94,11 @@ func resourceKeycloakGroupMembershipsRead(ctx context.Context, data *schema.Reso
 
 	var members []string
 	for _, userInGroup := range usersInGroup {
-		members = append(members, userInGroup.Username)
+		member, managed := groupMemberReference(userInGroup, tfMembers)
+		//only add members that we care about
+		if exhaustive || managed {
+			members = append(members, member)
+		}
 	}
 
 	data.Set("members", members)
@@ -92,17 +119,29 @@ func resourceKeycloakGroupMembershipsUpdate(ctx context.Context, data *schema.Re
 		return diag.FromErr(err)
 	}
 
+	exhaustive := data.Get("exhaustive").(bool)
+
+	if !exhaustive && data.HasChange("members") {
+		// members that were removed from the configuration are the only ones this resource may remove from the group
+		o, n := data.GetChange("members")
+		err = keycloakClient.RemoveUsersFromGroup(ctx, realmId, groupId, o.(*schema.Set).Difference(n.(*schema.Set)).List())
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	keycloakMembers, err := keycloakClient.GetGroupMembers(ctx, realmId, groupId)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
 	for _, keycloakMember := range keycloakMembers {
-		if tfMembers.Contains(keycloakMember.Username) {
+		member, managed := groupMemberReference(keycloakMember, tfMembers)
+		if managed {
 			// if the user exists in keycloak and tf state, no update is required for this member
 			// remove them from the set so we can look at members that need to be added later
-			tfMembers.Remove(keycloakMember.Username)
-		} else {
+			tfMembers.Remove(member)
+		} else if exhaustive {
 			// if the user exists in keycloak and not in tf state, they need to be removed from the group
 			err = keycloakClient.RemoveUserFromGroup(ctx, keycloakMember, groupId)
 			if err != nil {
@@ -131,6 +170,34 @@ func resourceKeycloakGroupMembershipsDelete(ctx context.Context, data *schema.Re
 	return diag.FromErr(keycloakClient.RemoveUsersFromGroup(ctx, realmId, groupId, data.Get("members").(*schema.Set).List()))
 }
 
+func resourceKeycloakGroupMembershipsImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("Invalid import. Supported import format: {{realm}}/{{groupId}}.")
+	}
+
+	realmId := parts[0]
+	groupId := parts[1]
+
+	_, err := keycloakClient.GetGroup(ctx, realmId, groupId)
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("realm_id", realmId)
+	d.Set("group_id", groupId)
+	d.Set("exhaustive", true)
+
+	diagnostics := resourceKeycloakGroupMembershipsRead(ctx, d, meta)
+	if diagnostics.HasError() {
+		return nil, errors.New(diagnostics[0].Summary)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func groupMembershipsId(realmId, groupId string) string {
 	return fmt.Sprintf("%s/group-memberships/%s", realmId, groupId)
 }
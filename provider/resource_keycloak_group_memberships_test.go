@@ -250,7 +250,7 @@ func TestAccKeycloakGroupMemberships_authoritativeRemove(t *testing.T) {
 	})
 }
 
-// this resource doesn't support import because it can be created even if the desired state already exists in keycloak
+// this resource can be created even if the desired state already exists in keycloak
 func TestAccKeycloakGroupMemberships_noImportNeeded(t *testing.T) {
 	t.Parallel()
 
@@ -284,6 +284,110 @@ func TestAccKeycloakGroupMemberships_noImportNeeded(t *testing.T) {
 	})
 }
 
+func TestAccKeycloakGroupMemberships_import(t *testing.T) {
+	t.Parallel()
+
+	groupName := acctest.RandomWithPrefix("tf-acc")
+	username := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakGroupMemberships_basic(groupName, username),
+				Check:  testAccCheckUserBelongsToGroup("keycloak_group_memberships.group_members", username),
+			},
+			{
+				ResourceName:      "keycloak_group_memberships.group_members",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources["keycloak_group_memberships.group_members"]
+					if !ok {
+						return "", fmt.Errorf("resource not found: keycloak_group_memberships.group_members")
+					}
+
+					return rs.Primary.Attributes["realm_id"] + "/" + rs.Primary.Attributes["group_id"], nil
+				},
+			},
+		},
+	})
+}
+
+func TestAccKeycloakGroupMemberships_userIds(t *testing.T) {
+	t.Parallel()
+
+	groupName := acctest.RandomWithPrefix("tf-acc")
+	username := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakGroupMemberships_userIds(groupName, username),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUserBelongsToGroup("keycloak_group_memberships.group_members", username),
+					resource.TestCheckTypeSetElemAttrPair("keycloak_group_memberships.group_members", "members.*", "keycloak_user.user", "id"),
+				),
+			},
+		},
+	})
+}
+
+// if a user is added to a group controlled by a non exhaustive resource, terraform should leave them in the group
+func TestAccKeycloakGroupMemberships_nonExhaustive(t *testing.T) {
+	t.Parallel()
+
+	groupName := acctest.RandomWithPrefix("tf-acc")
+
+	allUsersForTest := []string{
+		"terraform-user-" + acctest.RandString(10),
+		"terraform-user-" + acctest.RandString(10),
+		"terraform-user-" + acctest.RandString(10),
+	}
+	usersInGroup := allUsersForTest[:2]
+	userToManuallyAdd := allUsersForTest[2]
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakGroupMemberships_nonExhaustive(groupName, allUsersForTest, usersInGroup),
+				Check:  testAccCheckUsersBelongToGroup("keycloak_group_memberships.group_members", usersInGroup),
+			},
+			{
+				PreConfig: func() {
+					groupsWithName, err := keycloakClient.ListGroupsWithName(testCtx, testAccRealm.Realm, groupName)
+					if err != nil {
+						t.Fatal(err)
+					}
+
+					err = keycloakClient.AddUsersToGroup(testCtx, testAccRealm.Realm, groupsWithName[0].Id, []interface{}{userToManuallyAdd})
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testKeycloakGroupMemberships_nonExhaustive(groupName, allUsersForTest, usersInGroup),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUsersBelongToGroup("keycloak_group_memberships.group_members", allUsersForTest),
+					resource.TestCheckResourceAttr("keycloak_group_memberships.group_members", "members.#", "2"),
+				),
+			},
+			// removing a member from the configuration only removes that member from the group
+			{
+				Config: testKeycloakGroupMemberships_nonExhaustive(groupName, allUsersForTest, usersInGroup[:1]),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUsersBelongToGroup("keycloak_group_memberships.group_members", []string{usersInGroup[0], userToManuallyAdd}),
+					testAccCheckUsersDontBelongToGroup("keycloak_group_memberships.group_members", []string{usersInGroup[1]}),
+				),
+			},
+		},
+	})
+}
+
 func TestAccKeycloakGroupMemberships_validateLowercaseUsernames(t *testing.T) {
 	t.Parallel()
 
@@ -609,3 +713,68 @@ resource "keycloak_group_memberships" "group_members" {
 }
 	`, testAccRealm.Realm, group, username, hardcodedUsername)
 }
+
+func testKeycloakGroupMemberships_userIds(group, username string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_group" "group" {
+	name     = "%s"
+	realm_id = data.keycloak_realm.realm.id
+}
+
+resource "keycloak_user" "user" {
+	realm_id = data.keycloak_realm.realm.id
+	username = "%s"
+}
+
+resource "keycloak_group_memberships" "group_members" {
+	realm_id = data.keycloak_realm.realm.id
+	group_id = keycloak_group.group.id
+
+	members = [
+		keycloak_user.user.id
+	]
+}
+	`, testAccRealm.Realm, group, username)
+}
+
+func testKeycloakGroupMemberships_nonExhaustive(group string, definedUsers, usersInGroup []string) string {
+	var userResources strings.Builder
+	for _, username := range definedUsers {
+		userResources.WriteString(fmt.Sprintf(`
+resource "keycloak_user" "user_%s" {
+	realm_id = data.keycloak_realm.realm.id
+	username = "%s"
+}
+		`, username, username))
+	}
+
+	var usersInGroupInterpolated []string
+	for _, userInGroup := range usersInGroup {
+		usersInGroupInterpolated = append(usersInGroupInterpolated, fmt.Sprintf("${keycloak_user.user_%s.username}", userInGroup))
+	}
+
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_group" "group" {
+	name     = "%s"
+	realm_id = data.keycloak_realm.realm.id
+}
+
+%s
+
+resource "keycloak_group_memberships" "group_members" {
+	realm_id   = data.keycloak_realm.realm.id
+	group_id   = keycloak_group.group.id
+	exhaustive = false
+
+	members = %s
+}
+	`, testAccRealm.Realm, group, userResources.String(), arrayOfStringsForTerraformResource(usersInGroupInterpolated))
+}
@@ -22,6 +22,11 @@ var (
 	keycloakOpenidClientAuthorizationPolicyEnforcementMode   = []string{"ENFORCING", "PERMISSIVE", "DISABLED"}
 	keycloakOpenidClientResourcePermissionDecisionStrategies = []string{"UNANIMOUS", "AFFIRMATIVE", "CONSENSUS"}
 	keycloakOpenidClientPkceCodeChallengeMethod              = []string{"", "plain", "S256"}
+	keycloakOpenidClientJwtSigningAlgorithms                 = []string{"", "RS256", "RS384", "RS512", "PS256", "PS384", "PS512", "ES256", "ES384", "ES512", "EdDSA"}
+	keycloakOpenidClientTokenSigningAlgorithms               = []string{"", "RS256", "RS384", "RS512", "PS256", "PS384", "PS512", "ES256", "ES384", "ES512", "HS256", "HS384", "HS512", "EdDSA"}
+	keycloakOpenidClientTokenEncryptionAlgorithms            = []string{"", "RSA1_5", "RSA-OAEP", "RSA-OAEP-256", "ECDH-ES", "ECDH-ES+A128KW", "ECDH-ES+A192KW", "ECDH-ES+A256KW"}
+	keycloakOpenidClientTokenEncryptionMethods               = []string{"", "A128CBC-HS256", "A192CBC-HS384", "A256CBC-HS512", "A128GCM", "A192GCM", "A256GCM"}
+	keycloakOpenidClientRequestObjectRequired                = []string{"", "not required", "request or request_uri", "request only", "request_uri only"}
 )
 
 func resourceKeycloakOpenidClient() *schema.Resource {
@@ -76,6 +81,110 @@ func resourceKeycloakOpenidClient() *schema.Resource {
 				// No validation is performed since Keycloak plugins can register custom client authenticators
 				Default: "client-secret",
 			},
+			"client_jwt": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"jwks_url": {
+							Type:          schema.TypeString,
+							Optional:      true,
+							ConflictsWith: []string{"client_jwt.0.certificate", "client_jwt.0.jwks"},
+						},
+						"certificate": {
+							Type:          schema.TypeString,
+							Optional:      true,
+							ConflictsWith: []string{"client_jwt.0.jwks_url", "client_jwt.0.jwks"},
+						},
+						"jwks": {
+							Type:          schema.TypeString,
+							Optional:      true,
+							ConflictsWith: []string{"client_jwt.0.jwks_url", "client_jwt.0.certificate"},
+						},
+						"signing_alg": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice(keycloakOpenidClientJwtSigningAlgorithms, false),
+						},
+					},
+				},
+			},
+			"client_x509": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"subject_dn": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"allow_regex_pattern_comparison": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+			"token_security": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"access_token_signed_response_alg": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice(keycloakOpenidClientTokenSigningAlgorithms, false),
+						},
+						"id_token_signed_response_alg": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice(keycloakOpenidClientTokenSigningAlgorithms, false),
+						},
+						"id_token_encrypted_response_alg": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice(keycloakOpenidClientTokenEncryptionAlgorithms, false),
+						},
+						"id_token_encrypted_response_enc": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice(keycloakOpenidClientTokenEncryptionMethods, false),
+						},
+						"user_info_response_signature_alg": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice(append([]string{"unsigned"}, keycloakOpenidClientTokenSigningAlgorithms...), false),
+						},
+						"request_object_signature_alg": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice(append([]string{"any", "none"}, keycloakOpenidClientTokenSigningAlgorithms...), false),
+						},
+						"request_object_required": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice(keycloakOpenidClientRequestObjectRequired, false),
+						},
+						"authorization_signed_response_alg": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice(keycloakOpenidClientTokenSigningAlgorithms, false),
+						},
+						"tls_client_certificate_bound_access_tokens": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
 			"standard_flow_enabled": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -296,6 +405,40 @@ func resourceKeycloakOpenidClient() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"ciba_grant_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"ciba_backchannel_token_delivery_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(keycloakRealmValidCibaBackchannelTokenDeliveryModes, false),
+			},
+			"ciba_backchannel_client_notification_endpoint": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"ciba_backchannel_auth_request_signing_alg": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"require_pushed_authorization_requests": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"acr_loa_mapping": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeInt},
+			},
+			"default_acr_values": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			"import": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -303,12 +446,78 @@ func resourceKeycloakOpenidClient() *schema.Resource {
 				ForceNew: true,
 			},
 		},
-		CustomizeDiff: customdiff.ComputedIf("service_account_user_id", func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) bool {
-			return d.HasChange("service_accounts_enabled")
-		}),
+		CustomizeDiff: customdiff.All(
+			customdiff.ComputedIf("service_account_user_id", func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) bool {
+				return d.HasChange("service_accounts_enabled")
+			}),
+			resourceKeycloakOpenidClientAuthenticatorCustomizeDiff,
+			resourceKeycloakOpenidClientTokenSecurityCustomizeDiff,
+		),
 	}
 }
 
+// resourceKeycloakOpenidClientAuthenticatorCustomizeDiff checks the client_jwt and client_x509 blocks against the authenticator
+// type during plan. Values that are not known yet are checked during the next plan instead. Both blocks are computed, so only
+// blocks within the configuration are checked.
+func resourceKeycloakOpenidClientAuthenticatorCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if !d.NewValueKnown("client_authenticator_type") {
+		return nil
+	}
+
+	clientAuthenticatorType := d.Get("client_authenticator_type").(string)
+
+	if isBlockConfigured(d, "client_jwt") {
+		if clientAuthenticatorType != "client-jwt" && clientAuthenticatorType != "client-secret-jwt" {
+			return errors.New("client_jwt can only be set when client_authenticator_type is client-jwt or client-secret-jwt")
+		}
+
+		if clientAuthenticatorType == "client-secret-jwt" {
+			for _, key := range []string{"client_jwt.0.jwks_url", "client_jwt.0.certificate", "client_jwt.0.jwks"} {
+				if d.NewValueKnown(key) && d.Get(key).(string) != "" {
+					return errors.New("the client-secret-jwt authenticator only supports signing_alg within client_jwt")
+				}
+			}
+		}
+	}
+
+	if isBlockConfigured(d, "client_x509") && clientAuthenticatorType != "client-x509" {
+		return errors.New("client_x509 can only be set when client_authenticator_type is client-x509")
+	}
+
+	return nil
+}
+
+// resourceKeycloakOpenidClientTokenSecurityCustomizeDiff checks during plan that the realm has an active key for the signing
+// algorithms of the token_security block. Realms that do not exist yet are checked during the next plan instead.
+func resourceKeycloakOpenidClientTokenSecurityCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.HasChange("token_security") || !d.NewValueKnown("realm_id") || !d.NewValueKnown("token_security") {
+		return nil
+	}
+
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	attributes := &keycloak.OpenidClientAttributes{
+		AccessTokenSignedResponseAlg:   d.Get("token_security.0.access_token_signed_response_alg").(string),
+		IdTokenSignedResponseAlg:       d.Get("token_security.0.id_token_signed_response_alg").(string),
+		UserInfoResponseSignatureAlg:   d.Get("token_security.0.user_info_response_signature_alg").(string),
+		AuthorizationSignedResponseAlg: d.Get("token_security.0.authorization_signed_response_alg").(string),
+	}
+
+	err := keycloakClient.ValidateOpenidClientSigningAlgorithms(ctx, d.Get("realm_id").(string), attributes)
+	if err != nil && !keycloak.ErrorIs404(err) {
+		return err
+	}
+
+	return nil
+}
+
+// isBlockConfigured reports whether a block is set within the configuration, as opposed to being kept from state
+func isBlockConfigured(d *schema.ResourceDiff, key string) bool {
+	block := d.GetRawConfig().GetAttr(key)
+
+	return !block.IsNull() && block.IsKnown() && block.LengthInt() != 0
+}
+
 func getOpenidClientFromData(data *schema.ResourceData) (*keycloak.OpenidClient, error) {
 	validRedirectUris := make([]string, 0)
 	webOrigins := make([]string, 0)
@@ -339,6 +548,21 @@ func getOpenidClientFromData(data *schema.ResourceData) (*keycloak.OpenidClient,
 		}
 	}
 
+	defaultAcrValues := make([]string, 0)
+	for _, defaultAcrValue := range data.Get("default_acr_values").([]interface{}) {
+		defaultAcrValues = append(defaultAcrValues, defaultAcrValue.(string))
+	}
+
+	acrLoaMapping := map[string]int{}
+	for acr, level := range data.Get("acr_loa_mapping").(map[string]interface{}) {
+		acrLoaMapping[acr] = level.(int)
+	}
+
+	acrLoaMap, err := keycloak.FormatAcrLoaMapping(acrLoaMapping)
+	if err != nil {
+		return nil, err
+	}
+
 	openidClient := &keycloak.OpenidClient{
 		Id:                        data.Id(),
 		ClientId:                  data.Get("client_id").(string),
@@ -355,27 +579,34 @@ func getOpenidClientFromData(data *schema.ResourceData) (*keycloak.OpenidClient,
 		FrontChannelLogoutEnabled: data.Get("frontchannel_logout_enabled").(bool),
 		FullScopeAllowed:          data.Get("full_scope_allowed").(bool),
 		Attributes: keycloak.OpenidClientAttributes{
-			PkceCodeChallengeMethod:               data.Get("pkce_code_challenge_method").(string),
-			ExcludeSessionStateFromAuthResponse:   types.KeycloakBoolQuoted(data.Get("exclude_session_state_from_auth_response").(bool)),
-			AccessTokenLifespan:                   data.Get("access_token_lifespan").(string),
-			LoginTheme:                            data.Get("login_theme").(string),
-			ClientOfflineSessionIdleTimeout:       data.Get("client_offline_session_idle_timeout").(string),
-			ClientOfflineSessionMaxLifespan:       data.Get("client_offline_session_max_lifespan").(string),
-			ClientSessionIdleTimeout:              data.Get("client_session_idle_timeout").(string),
-			ClientSessionMaxLifespan:              data.Get("client_session_max_lifespan").(string),
-			UseRefreshTokens:                      types.KeycloakBoolQuoted(data.Get("use_refresh_tokens").(bool)),
-			UseRefreshTokensClientCredentials:     types.KeycloakBoolQuoted(data.Get("use_refresh_tokens_client_credentials").(bool)),
-			FrontchannelLogoutUrl:                 data.Get("frontchannel_logout_url").(string),
-			BackchannelLogoutUrl:                  data.Get("backchannel_logout_url").(string),
-			BackchannelLogoutRevokeOfflineTokens:  types.KeycloakBoolQuoted(data.Get("backchannel_logout_revoke_offline_sessions").(bool)),
-			BackchannelLogoutSessionRequired:      types.KeycloakBoolQuoted(data.Get("backchannel_logout_session_required").(bool)),
-			ExtraConfig:                           getExtraConfigFromData(data),
-			Oauth2DeviceAuthorizationGrantEnabled: types.KeycloakBoolQuoted(data.Get("oauth2_device_authorization_grant_enabled").(bool)),
-			Oauth2DeviceCodeLifespan:              data.Get("oauth2_device_code_lifespan").(string),
-			Oauth2DevicePollingInterval:           data.Get("oauth2_device_polling_interval").(string),
-			ConsentScreenText:                     data.Get("consent_screen_text").(string),
-			DisplayOnConsentScreen:                types.KeycloakBoolQuoted(data.Get("display_on_consent_screen").(bool)),
-			PostLogoutRedirectUris:                types.KeycloakSliceHashDelimited(validPostLogoutRedirectUris),
+			PkceCodeChallengeMethod:                   data.Get("pkce_code_challenge_method").(string),
+			ExcludeSessionStateFromAuthResponse:       types.KeycloakBoolQuoted(data.Get("exclude_session_state_from_auth_response").(bool)),
+			AccessTokenLifespan:                       data.Get("access_token_lifespan").(string),
+			LoginTheme:                                data.Get("login_theme").(string),
+			ClientOfflineSessionIdleTimeout:           data.Get("client_offline_session_idle_timeout").(string),
+			ClientOfflineSessionMaxLifespan:           data.Get("client_offline_session_max_lifespan").(string),
+			ClientSessionIdleTimeout:                  data.Get("client_session_idle_timeout").(string),
+			ClientSessionMaxLifespan:                  data.Get("client_session_max_lifespan").(string),
+			UseRefreshTokens:                          types.KeycloakBoolQuoted(data.Get("use_refresh_tokens").(bool)),
+			UseRefreshTokensClientCredentials:         types.KeycloakBoolQuoted(data.Get("use_refresh_tokens_client_credentials").(bool)),
+			FrontchannelLogoutUrl:                     data.Get("frontchannel_logout_url").(string),
+			BackchannelLogoutUrl:                      data.Get("backchannel_logout_url").(string),
+			BackchannelLogoutRevokeOfflineTokens:      types.KeycloakBoolQuoted(data.Get("backchannel_logout_revoke_offline_sessions").(bool)),
+			BackchannelLogoutSessionRequired:          types.KeycloakBoolQuoted(data.Get("backchannel_logout_session_required").(bool)),
+			ExtraConfig:                               getExtraConfigFromData(data),
+			Oauth2DeviceAuthorizationGrantEnabled:     types.KeycloakBoolQuoted(data.Get("oauth2_device_authorization_grant_enabled").(bool)),
+			Oauth2DeviceCodeLifespan:                  data.Get("oauth2_device_code_lifespan").(string),
+			Oauth2DevicePollingInterval:               data.Get("oauth2_device_polling_interval").(string),
+			CibaGrantEnabled:                          types.KeycloakBoolQuoted(data.Get("ciba_grant_enabled").(bool)),
+			CibaBackchannelTokenDeliveryMode:          data.Get("ciba_backchannel_token_delivery_mode").(string),
+			CibaBackchannelClientNotificationEndpoint: data.Get("ciba_backchannel_client_notification_endpoint").(string),
+			CibaBackchannelAuthRequestSigningAlg:      data.Get("ciba_backchannel_auth_request_signing_alg").(string),
+			RequirePushedAuthorizationRequests:        types.KeycloakBoolQuoted(data.Get("require_pushed_authorization_requests").(bool)),
+			ConsentScreenText:                         data.Get("consent_screen_text").(string),
+			DisplayOnConsentScreen:                    types.KeycloakBoolQuoted(data.Get("display_on_consent_screen").(bool)),
+			PostLogoutRedirectUris:                    types.KeycloakSliceHashDelimited(validPostLogoutRedirectUris),
+			AcrLoaMap:                                 acrLoaMap,
+			DefaultAcrValues:                          types.KeycloakSliceHashDelimited(defaultAcrValues),
 		},
 		ValidRedirectUris: validRedirectUris,
 		WebOrigins:        webOrigins,
@@ -421,6 +652,56 @@ func getOpenidClientFromData(data *schema.ResourceData) (*keycloak.OpenidClient,
 		openidClient.AuthorizationServicesEnabled = false
 	}
 
+	if v, ok := data.GetOk("client_jwt"); ok {
+		clientJwt := v.([]interface{})[0].(map[string]interface{})
+		openidClient.Attributes.JwksUrl = clientJwt["jwks_url"].(string)
+		openidClient.Attributes.UseJwksUrl = openidClient.Attributes.JwksUrl != ""
+		openidClient.Attributes.TokenEndpointAuthSigningAlg = clientJwt["signing_alg"].(string)
+	}
+
+	if v, ok := data.GetOk("client_x509"); ok {
+		clientX509 := v.([]interface{})[0].(map[string]interface{})
+		openidClient.Attributes.X509SubjectDn = clientX509["subject_dn"].(string)
+		openidClient.Attributes.X509AllowRegexPatternComparison = types.KeycloakBoolQuoted(clientX509["allow_regex_pattern_comparison"].(bool))
+	}
+
+	if v, ok := data.GetOk("token_security"); ok {
+		tokenSecurity := v.([]interface{})[0].(map[string]interface{})
+		openidClient.Attributes.AccessTokenSignedResponseAlg = tokenSecurity["access_token_signed_response_alg"].(string)
+		openidClient.Attributes.IdTokenSignedResponseAlg = tokenSecurity["id_token_signed_response_alg"].(string)
+		openidClient.Attributes.IdTokenEncryptedResponseAlg = tokenSecurity["id_token_encrypted_response_alg"].(string)
+		openidClient.Attributes.IdTokenEncryptedResponseEnc = tokenSecurity["id_token_encrypted_response_enc"].(string)
+		openidClient.Attributes.UserInfoResponseSignatureAlg = tokenSecurity["user_info_response_signature_alg"].(string)
+		openidClient.Attributes.RequestObjectSignatureAlg = tokenSecurity["request_object_signature_alg"].(string)
+		openidClient.Attributes.RequestObjectRequired = tokenSecurity["request_object_required"].(string)
+		openidClient.Attributes.AuthorizationSignedResponseAlg = tokenSecurity["authorization_signed_response_alg"].(string)
+		openidClient.Attributes.TlsClientCertificateBoundAccessTokens = types.KeycloakBoolQuoted(tokenSecurity["tls_client_certificate_bound_access_tokens"].(bool))
+	}
+
+	setRemovedAttributesToEmpty(data, openidClient.Attributes.ExtraConfig, map[string][]string{
+		"valid_post_logout_redirect_uris":                             {"post.logout.redirect.uris"},
+		"oauth2_device_code_lifespan":                                 {"oauth2.device.code.lifespan"},
+		"oauth2_device_polling_interval":                              {"oauth2.device.polling.interval"},
+		"ciba_backchannel_token_delivery_mode":                        {"ciba.backchannel.token.delivery.mode"},
+		"ciba_backchannel_client_notification_endpoint":               {"ciba.backchannel.client.notification.endpoint"},
+		"ciba_backchannel_auth_request_signing_alg":                   {"ciba.backchannel.auth.request.signing.alg"},
+		"acr_loa_mapping":                                             {"acr.loa.map"},
+		"default_acr_values":                                          {"default.acr.values"},
+		"client_jwt.0.jwks_url":                                       {"use.jwks.url", "jwks.url"},
+		"client_jwt.0.signing_alg":                                    {"token.endpoint.auth.signing.alg"},
+		"client_x509.0.subject_dn":                                    {"x509.subjectdn"},
+		"client_x509.0.allow_regex_pattern_comparison":                {"x509.allow.regex.pattern.comparison"},
+		"token_security.0.access_token_signed_response_alg":           {"access.token.signed.response.alg"},
+		"token_security.0.id_token_signed_response_alg":               {"id.token.signed.response.alg"},
+		"token_security.0.id_token_encrypted_response_alg":            {"id.token.encrypted.response.alg"},
+		"token_security.0.id_token_encrypted_response_enc":            {"id.token.encrypted.response.enc"},
+		"token_security.0.user_info_response_signature_alg":           {"user.info.response.signature.alg"},
+		"token_security.0.request_object_signature_alg":               {"request.object.signature.alg"},
+		"token_security.0.request_object_required":                    {"request.object.required"},
+		"token_security.0.authorization_signed_response_alg":          {"authorization.signed.response.alg"},
+		"token_security.0.tls_client_certificate_bound_access_tokens": {"tls.client.certificate.bound.access.tokens"},
+	})
+
 	if v, ok := data.GetOk("authentication_flow_binding_overrides"); ok {
 		authenticationFlowBindingOverridesData := v.(*schema.Set).List()[0]
 		authenticationFlowBindingOverrides := authenticationFlowBindingOverridesData.(map[string]interface{})
@@ -471,6 +752,18 @@ func setOpenidClientData(ctx context.Context, keycloakClient *keycloak.KeycloakC
 	data.Set("oauth2_device_authorization_grant_enabled", client.Attributes.Oauth2DeviceAuthorizationGrantEnabled)
 	data.Set("oauth2_device_code_lifespan", client.Attributes.Oauth2DeviceCodeLifespan)
 	data.Set("oauth2_device_polling_interval", client.Attributes.Oauth2DevicePollingInterval)
+	data.Set("ciba_grant_enabled", client.Attributes.CibaGrantEnabled)
+	data.Set("ciba_backchannel_token_delivery_mode", client.Attributes.CibaBackchannelTokenDeliveryMode)
+	data.Set("ciba_backchannel_client_notification_endpoint", client.Attributes.CibaBackchannelClientNotificationEndpoint)
+	data.Set("ciba_backchannel_auth_request_signing_alg", client.Attributes.CibaBackchannelAuthRequestSigningAlg)
+	data.Set("require_pushed_authorization_requests", client.Attributes.RequirePushedAuthorizationRequests)
+	data.Set("default_acr_values", client.Attributes.DefaultAcrValues)
+
+	acrLoaMapping, err := keycloak.ParseAcrLoaMapping(client.Attributes.AcrLoaMap)
+	if err != nil {
+		return err
+	}
+	data.Set("acr_loa_mapping", acrLoaMapping)
 	data.Set("client_offline_session_idle_timeout", client.Attributes.ClientOfflineSessionIdleTimeout)
 	data.Set("client_offline_session_max_lifespan", client.Attributes.ClientOfflineSessionMaxLifespan)
 	data.Set("client_session_idle_timeout", client.Attributes.ClientSessionIdleTimeout)
@@ -502,6 +795,48 @@ func setOpenidClientData(ctx context.Context, keycloakClient *keycloak.KeycloakC
 		data.Set("access_type", "CONFIDENTIAL")
 	}
 
+	if (client.ClientAuthenticatorType == "client-jwt" || client.ClientAuthenticatorType == "client-secret-jwt") && (client.Attributes.JwksUrl != "" || client.Attributes.TokenEndpointAuthSigningAlg != "" || len(data.Get("client_jwt").([]interface{})) != 0) {
+		// uploaded certificates cannot be compared with the configuration, so they are kept as configured
+		data.Set("client_jwt", []interface{}{
+			map[string]interface{}{
+				"jwks_url":    client.Attributes.JwksUrl,
+				"certificate": data.Get("client_jwt.0.certificate").(string),
+				"jwks":        data.Get("client_jwt.0.jwks").(string),
+				"signing_alg": client.Attributes.TokenEndpointAuthSigningAlg,
+			},
+		})
+	} else {
+		data.Set("client_jwt", nil)
+	}
+
+	if client.Attributes.X509SubjectDn != "" {
+		data.Set("client_x509", []interface{}{
+			map[string]interface{}{
+				"subject_dn":                     client.Attributes.X509SubjectDn,
+				"allow_regex_pattern_comparison": bool(client.Attributes.X509AllowRegexPatternComparison),
+			},
+		})
+	} else {
+		data.Set("client_x509", nil)
+	}
+
+	tokenSecurity := map[string]interface{}{
+		"access_token_signed_response_alg":           client.Attributes.AccessTokenSignedResponseAlg,
+		"id_token_signed_response_alg":               client.Attributes.IdTokenSignedResponseAlg,
+		"id_token_encrypted_response_alg":            client.Attributes.IdTokenEncryptedResponseAlg,
+		"id_token_encrypted_response_enc":            client.Attributes.IdTokenEncryptedResponseEnc,
+		"user_info_response_signature_alg":           client.Attributes.UserInfoResponseSignatureAlg,
+		"request_object_signature_alg":               client.Attributes.RequestObjectSignatureAlg,
+		"request_object_required":                    client.Attributes.RequestObjectRequired,
+		"authorization_signed_response_alg":          client.Attributes.AuthorizationSignedResponseAlg,
+		"tls_client_certificate_bound_access_tokens": bool(client.Attributes.TlsClientCertificateBoundAccessTokens),
+	}
+	if openidClientHasTokenSecurity(client) {
+		data.Set("token_security", []interface{}{tokenSecurity})
+	} else {
+		data.Set("token_security", nil)
+	}
+
 	if (keycloak.OpenidAuthenticationFlowBindingOverrides{}) == client.AuthenticationFlowBindingOverrides {
 		data.Set("authentication_flow_binding_overrides", nil)
 	} else {
@@ -527,12 +862,19 @@ func resourceKeycloakOpenidClientCreate(ctx context.Context, data *schema.Resour
 		return diag.FromErr(err)
 	}
 
+	setOwnershipTagAttribute(keycloakClient, client.Attributes.ExtraConfig)
+
 	if data.Get("import").(bool) {
 		existingClient, err := keycloakClient.GetOpenidClientByClientId(ctx, client.RealmId, client.ClientId)
 		if err != nil {
 			return diag.FromErr(err)
 		}
 
+		err = keycloakClient.ValidateOwnership(fmt.Sprintf("openid client %s", existingClient.ClientId), getOwnerFromAttributes(existingClient.Attributes.ExtraConfig))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
 		if err = mergo.Merge(client, existingClient); err != nil {
 			return diag.FromErr(err)
 		}
@@ -548,6 +890,11 @@ func resourceKeycloakOpenidClientCreate(ctx context.Context, data *schema.Resour
 		}
 	}
 
+	err = uploadOpenidClientJwtCertificate(ctx, keycloakClient, data, client)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	err = setOpenidClientData(ctx, keycloakClient, data, client)
 	if err != nil {
 		return diag.FromErr(err)
@@ -567,6 +914,11 @@ func resourceKeycloakOpenidClientRead(ctx context.Context, data *schema.Resource
 		return handleNotFoundError(ctx, err, data)
 	}
 
+	err = keycloakClient.ValidateOwnership(fmt.Sprintf("openid client %s", client.ClientId), getOwnerFromAttributes(client.Attributes.ExtraConfig))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	err = setOpenidClientData(ctx, keycloakClient, data, client)
 	if err != nil {
 		return diag.FromErr(err)
@@ -588,11 +940,20 @@ func resourceKeycloakOpenidClientUpdate(ctx context.Context, data *schema.Resour
 		return diag.FromErr(err)
 	}
 
+	setOwnershipTagAttribute(keycloakClient, client.Attributes.ExtraConfig)
+
 	err = keycloakClient.UpdateOpenidClient(ctx, client)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
+	if data.HasChanges("client_jwt.0.certificate", "client_jwt.0.jwks") {
+		err = uploadOpenidClientJwtCertificate(ctx, keycloakClient, data, client)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	err = setOpenidClientData(ctx, keycloakClient, data, client)
 	if err != nil {
 		return diag.FromErr(err)
@@ -601,6 +962,33 @@ func resourceKeycloakOpenidClientUpdate(ctx context.Context, data *schema.Resour
 	return nil
 }
 
+func openidClientHasTokenSecurity(client *keycloak.OpenidClient) bool {
+	attributes := client.Attributes
+
+	return attributes.AccessTokenSignedResponseAlg != "" ||
+		attributes.IdTokenSignedResponseAlg != "" ||
+		attributes.IdTokenEncryptedResponseAlg != "" ||
+		attributes.IdTokenEncryptedResponseEnc != "" ||
+		attributes.UserInfoResponseSignatureAlg != "" ||
+		attributes.RequestObjectSignatureAlg != "" ||
+		attributes.RequestObjectRequired != "" ||
+		attributes.AuthorizationSignedResponseAlg != "" ||
+		bool(attributes.TlsClientCertificateBoundAccessTokens)
+}
+
+// uploadOpenidClientJwtCertificate uploads the certificate or JWKS from the client_jwt block, if there is one
+func uploadOpenidClientJwtCertificate(ctx context.Context, keycloakClient *keycloak.KeycloakClient, data *schema.ResourceData, client *keycloak.OpenidClient) error {
+	if certificate := data.Get("client_jwt.0.certificate").(string); certificate != "" {
+		return keycloakClient.UploadOpenidClientJwtCertificate(ctx, client.RealmId, client.Id, keycloak.OpenidClientJwtCertificateFormatPem, certificate)
+	}
+
+	if jwks := data.Get("client_jwt.0.jwks").(string); jwks != "" {
+		return keycloakClient.UploadOpenidClientJwtCertificate(ctx, client.RealmId, client.Id, keycloak.OpenidClientJwtCertificateFormatJwks, jwks)
+	}
+
+	return nil
+}
+
 func resourceKeycloakOpenidClientDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	if data.Get("import").(bool) {
 		return nil
@@ -610,6 +998,20 @@ func resourceKeycloakOpenidClientDelete(ctx context.Context, data *schema.Resour
 	realmId := data.Get("realm_id").(string)
 	id := data.Id()
 
+	client, err := keycloakClient.GetOpenidClient(ctx, realmId, id)
+	if err != nil {
+		if keycloak.ErrorIs404(err) {
+			return nil
+		}
+
+		return diag.FromErr(err)
+	}
+
+	err = keycloakClient.ValidateOwnership(fmt.Sprintf("openid client %s", client.ClientId), getOwnerFromAttributes(client.Attributes.ExtraConfig))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	return diag.FromErr(keycloakClient.DeleteOpenidClient(ctx, realmId, id))
 }
 
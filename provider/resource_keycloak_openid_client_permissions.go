@@ -21,34 +21,38 @@ func resourceKeycloakOpenidClientPermissions() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceKeycloakOpenidClientPermissionsImport,
 		},
-		Schema: map[string]*schema.Schema{
-			"realm_id": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
-			},
-			"enabled": {
-				Type:     schema.TypeBool,
-				Computed: true,
-			},
-			"client_id": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
-			},
-			"authorization_resource_server_id": {
-				Type:        schema.TypeString,
-				Computed:    true,
-				Description: "Resource server id representing the realm management client on which this permission is managed",
-			},
-			"view_scope":                   scopePermissionsSchema(),
-			"manage_scope":                 scopePermissionsSchema(),
-			"configure_scope":              scopePermissionsSchema(),
-			"map_roles_scope":              scopePermissionsSchema(),
-			"map_roles_client_scope_scope": scopePermissionsSchema(),
-			"map_roles_composite_scope":    scopePermissionsSchema(),
-			"token_exchange_scope":         scopePermissionsSchema(),
+		Schema: clientPermissionsSchema(),
+	}
+}
+
+func clientPermissionsSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"realm_id": {
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+		"enabled": {
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+		"client_id": {
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+		"authorization_resource_server_id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Resource server id representing the realm management client on which this permission is managed",
 		},
+		"view_scope":                   scopePermissionsSchema(),
+		"manage_scope":                 scopePermissionsSchema(),
+		"configure_scope":              scopePermissionsSchema(),
+		"map_roles_scope":              scopePermissionsSchema(),
+		"map_roles_client_scope_scope": scopePermissionsSchema(),
+		"map_roles_composite_scope":    scopePermissionsSchema(),
+		"token_exchange_scope":         scopePermissionsSchema(),
 	}
 }
 
@@ -0,0 +1,224 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+)
+
+func resourceKeycloakOpenidClientSecretRotation() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceKeycloakOpenidClientSecretRotationCreate,
+		ReadContext:   resourceKeycloakOpenidClientSecretRotationRead,
+		UpdateContext: resourceKeycloakOpenidClientSecretRotationUpdate,
+		DeleteContext: resourceKeycloakOpenidClientSecretRotationDelete,
+		// This resource can be imported using {{realm}}/{{clientId}}. The Client ID is the unique ID Keycloak assigns to the client upon creation.
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceKeycloakOpenidClientSecretRotationImport,
+		},
+		CustomizeDiff: resourceKeycloakOpenidClientSecretRotationCustomizeDiff,
+		Schema: map[string]*schema.Schema{
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"client_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"rotation_triggers": {
+				Type:     schema.TypeMap,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Optional: true,
+			},
+			"invalidate_rotated_triggers": {
+				Type:     schema.TypeMap,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Optional: true,
+			},
+			"rotated_secret_lifespan": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				DiffSuppressFunc: suppressDurationStringDiff,
+				ValidateFunc: func(i interface{}, k string) ([]string, []error) {
+					if _, err := time.ParseDuration(i.(string)); err != nil {
+						return nil, []error{fmt.Errorf("expected %s to be a duration, got %s", k, i.(string))}
+					}
+
+					return nil, nil
+				},
+			},
+			"client_secret": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"client_secret_creation_time": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"rotated_client_secret": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"rotated_client_secret_creation_time": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"rotated_client_secret_expiration_time": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// rotatedClientSecretExpired reports whether the rotated secret has outlived the lifespan configured on this resource
+func rotatedClientSecretExpired(lifespan, rotatedClientSecret string, rotatedClientSecretCreationTime int64) bool {
+	if lifespan == "" || rotatedClientSecret == "" || rotatedClientSecretCreationTime == 0 {
+		return false
+	}
+
+	seconds, err := getSecondsFromDurationString(lifespan)
+	if err != nil {
+		return false
+	}
+
+	return time.Now().Unix() >= rotatedClientSecretCreationTime+int64(seconds)
+}
+
+func resourceKeycloakOpenidClientSecretRotationCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+
+	if d.HasChange("rotation_triggers") {
+		for _, key := range []string{"client_secret", "client_secret_creation_time", "rotated_client_secret", "rotated_client_secret_creation_time", "rotated_client_secret_expiration_time"} {
+			if err := d.SetNewComputed(key); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	expired := rotatedClientSecretExpired(d.Get("rotated_secret_lifespan").(string), d.Get("rotated_client_secret").(string), int64(d.Get("rotated_client_secret_creation_time").(int)))
+
+	if d.HasChange("invalidate_rotated_triggers") || expired {
+		for _, key := range []string{"rotated_client_secret", "rotated_client_secret_creation_time", "rotated_client_secret_expiration_time"} {
+			if err := d.SetNewComputed(key); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func setOpenidClientSecretRotationData(data *schema.ResourceData, rotation *keycloak.OpenidClientSecretRotation) {
+	data.SetId(rotation.RealmId + "/" + rotation.ClientId)
+
+	data.Set("realm_id", rotation.RealmId)
+	data.Set("client_id", rotation.ClientId)
+	data.Set("client_secret", rotation.ClientSecret)
+	data.Set("client_secret_creation_time", rotation.ClientSecretCreationTime)
+	data.Set("rotated_client_secret", rotation.RotatedClientSecret)
+	data.Set("rotated_client_secret_creation_time", rotation.RotatedClientSecretCreationTime)
+	data.Set("rotated_client_secret_expiration_time", rotation.RotatedClientSecretExpirationTime)
+}
+
+func resourceKeycloakOpenidClientSecretRotationCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	versionOk, err := keycloakClient.VersionIsGreaterThanOrEqualTo(ctx, keycloak.Version_20)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !versionOk {
+		return diag.Errorf("keycloak_openid_client_secret_rotation requires Keycloak 20 or later")
+	}
+
+	realmId := data.Get("realm_id").(string)
+	clientId := data.Get("client_id").(string)
+
+	// the current secret of the client is adopted as is, it is only regenerated once the rotation triggers change
+	rotation, err := keycloakClient.GetOpenidClientSecretRotation(ctx, realmId, clientId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	setOpenidClientSecretRotationData(data, rotation)
+
+	return nil
+}
+
+func resourceKeycloakOpenidClientSecretRotationRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	clientId := data.Get("client_id").(string)
+
+	rotation, err := keycloakClient.GetOpenidClientSecretRotation(ctx, realmId, clientId)
+	if err != nil {
+		return handleNotFoundError(ctx, err, data)
+	}
+
+	setOpenidClientSecretRotationData(data, rotation)
+
+	return nil
+}
+
+func resourceKeycloakOpenidClientSecretRotationUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	clientId := data.Get("client_id").(string)
+
+	rotation, err := keycloakClient.GetOpenidClientSecretRotation(ctx, realmId, clientId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// the rotated secret is invalidated first, so that a secret regenerated in the same apply keeps the previous one as its rotated secret
+	expired := rotatedClientSecretExpired(data.Get("rotated_secret_lifespan").(string), rotation.RotatedClientSecret, rotation.RotatedClientSecretCreationTime)
+	if rotation.RotatedClientSecret != "" && (data.HasChange("invalidate_rotated_triggers") || expired) {
+		err = keycloakClient.InvalidateOpenidClientRotatedSecret(ctx, realmId, clientId)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if data.HasChange("rotation_triggers") {
+		err = keycloakClient.RegenerateOpenidClientSecret(ctx, realmId, clientId)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceKeycloakOpenidClientSecretRotationRead(ctx, data, meta)
+}
+
+// the secrets belong to the client, so they are left as they are when this resource is destroyed
+func resourceKeycloakOpenidClientSecretRotationDelete(_ context.Context, _ *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	return nil
+}
+
+func resourceKeycloakOpenidClientSecretRotationImport(ctx context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("Invalid import. Supported import format: {{realm}}/{{clientId}}.")
+	}
+
+	d.Set("realm_id", parts[0])
+	d.Set("client_id", parts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
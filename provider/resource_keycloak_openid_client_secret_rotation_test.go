@@ -0,0 +1,92 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+)
+
+func TestAccKeycloakOpenidClientSecretRotation_basic(t *testing.T) {
+	skipIfVersionIsLessThanOrEqualTo(testCtx, t, keycloakClient, keycloak.Version_19)
+	t.Parallel()
+
+	clientId := acctest.RandomWithPrefix("tf-acc")
+	resourceName := "keycloak_openid_client_secret_rotation.rotation"
+
+	var clientSecret string
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakOpenidClientDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakOpenidClientSecretRotation_basic(clientId, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "client_secret", "keycloak_openid_client.client", "client_secret"),
+					testAccCheckKeycloakOpenidClientSecretRotationSecret(resourceName, &clientSecret, false),
+				),
+			},
+			{
+				Config: testKeycloakOpenidClientSecretRotation_basic(clientId, "2"),
+				Check:  testAccCheckKeycloakOpenidClientSecretRotationSecret(resourceName, &clientSecret, true),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"rotation_triggers"},
+			},
+		},
+	})
+}
+
+// testAccCheckKeycloakOpenidClientSecretRotationSecret stores the secret from the state, and checks whether it differs from the one stored before
+func testAccCheckKeycloakOpenidClientSecretRotationSecret(resourceName string, clientSecret *string, expectRotated bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		secret := rs.Primary.Attributes["client_secret"]
+		if secret == "" {
+			return fmt.Errorf("expected %s to have a client secret", resourceName)
+		}
+
+		if expectRotated && secret == *clientSecret {
+			return fmt.Errorf("expected the client secret of %s to have been rotated", resourceName)
+		}
+
+		*clientSecret = secret
+
+		return nil
+	}
+}
+
+func testKeycloakOpenidClientSecretRotation_basic(clientId, rotation string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_openid_client" "client" {
+	realm_id    = data.keycloak_realm.realm.id
+	client_id   = "%s"
+	access_type = "CONFIDENTIAL"
+}
+
+resource "keycloak_openid_client_secret_rotation" "rotation" {
+	realm_id  = data.keycloak_realm.realm.id
+	client_id = keycloak_openid_client.client.id
+
+	rotation_triggers = {
+		rotation = "%s"
+	}
+}
+	`, testAccRealm.Realm, clientId, rotation)
+}
@@ -167,13 +167,195 @@ func TestAccKeycloakOpenidClient_clientAuthenticatorType(t *testing.T) {
 				Check:  testAccCheckKeycloakOpenidClientAuthenticatorType("keycloak_openid_client.client", "client-secret-jwt"),
 			},
 			{
-				Config: testKeycloakOpenidClient_clientAuthenticatorType(clientId, "client-x509"),
+				Config: testKeycloakOpenidClient_clientX509(clientId, "CN=client", false),
 				Check:  testAccCheckKeycloakOpenidClientAuthenticatorType("keycloak_openid_client.client", "client-x509"),
 			},
 		},
 	})
 }
 
+func TestAccKeycloakOpenidClient_clientJwt(t *testing.T) {
+	t.Parallel()
+	clientId := acctest.RandomWithPrefix("tf-acc")
+	_, certificate := generateKeyAndCert(2048)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakOpenidClientDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakOpenidClient_clientJwtJwksUrl(clientId, "https://example.com/jwks"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakOpenidClientAuthenticatorType("keycloak_openid_client.client", "client-jwt"),
+					resource.TestCheckResourceAttr("keycloak_openid_client.client", "client_jwt.0.jwks_url", "https://example.com/jwks"),
+					resource.TestCheckResourceAttr("keycloak_openid_client.client", "client_jwt.0.signing_alg", "RS256"),
+					func(s *terraform.State) error {
+						client, err := getOpenidClientFromState(s, "keycloak_openid_client.client")
+						if err != nil {
+							return err
+						}
+
+						if !client.Attributes.UseJwksUrl {
+							return fmt.Errorf("expected openid client to use its jwks url")
+						}
+
+						return nil
+					},
+				),
+			},
+			{
+				Config: testKeycloakOpenidClient_clientJwtCertificate(clientId, certificate),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("keycloak_openid_client.client", "client_jwt.0.jwks_url", ""),
+					resource.TestCheckResourceAttr("keycloak_openid_client.client", "client_jwt.0.certificate", certificate),
+					func(s *terraform.State) error {
+						client, err := getOpenidClientFromState(s, "keycloak_openid_client.client")
+						if err != nil {
+							return err
+						}
+
+						if client.Attributes.UseJwksUrl {
+							return fmt.Errorf("expected openid client to not use a jwks url")
+						}
+
+						if client.Attributes.ExtraConfig["jwt.credential.certificate"] != certificate {
+							return fmt.Errorf("expected openid client to have the uploaded certificate")
+						}
+
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakOpenidClient_clientX509(t *testing.T) {
+	t.Parallel()
+	clientId := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakOpenidClientDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakOpenidClient_clientX509(clientId, "CN=client", false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("keycloak_openid_client.client", "client_x509.0.subject_dn", "CN=client"),
+					resource.TestCheckResourceAttr("keycloak_openid_client.client", "client_x509.0.allow_regex_pattern_comparison", "false"),
+				),
+			},
+			{
+				Config: testKeycloakOpenidClient_clientX509(clientId, "CN=client-.*", true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("keycloak_openid_client.client", "client_x509.0.subject_dn", "CN=client-.*"),
+					resource.TestCheckResourceAttr("keycloak_openid_client.client", "client_x509.0.allow_regex_pattern_comparison", "true"),
+				),
+			},
+			{
+				Config: testKeycloakOpenidClient_clientX509(clientId, "CN=client", false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("keycloak_openid_client.client", "client_x509.0.subject_dn", "CN=client"),
+					resource.TestCheckResourceAttr("keycloak_openid_client.client", "client_x509.0.allow_regex_pattern_comparison", "false"),
+				),
+			},
+			{
+				ResourceName:            "keycloak_openid_client.client",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateIdPrefix:     testAccRealm.Realm + "/",
+				ImportStateVerifyIgnore: []string{"exclude_session_state_from_auth_response"},
+			},
+		},
+	})
+}
+
+func TestAccKeycloakOpenidClient_clientAuthenticatorBlockValidation(t *testing.T) {
+	t.Parallel()
+	clientId := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakOpenidClientDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config:      testKeycloakOpenidClient_clientAuthenticatorBlockMismatch(clientId),
+				ExpectError: regexp.MustCompile("client_x509 can only be set when client_authenticator_type is client-x509"),
+			},
+			{
+				Config:      testKeycloakOpenidClient_clientAuthenticatorType(clientId, "client-x509"),
+				ExpectError: regexp.MustCompile("the client-x509 authenticator requires a subject DN"),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakOpenidClient_tokenSecurity(t *testing.T) {
+	t.Parallel()
+	clientId := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakOpenidClientDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakOpenidClient_tokenSecurity(clientId, "RS256"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("keycloak_openid_client.client", "token_security.0.access_token_signed_response_alg", "RS256"),
+					resource.TestCheckResourceAttr("keycloak_openid_client.client", "token_security.0.id_token_signed_response_alg", "RS256"),
+					resource.TestCheckResourceAttr("keycloak_openid_client.client", "token_security.0.request_object_required", "request or request_uri"),
+					resource.TestCheckResourceAttr("keycloak_openid_client.client", "token_security.0.tls_client_certificate_bound_access_tokens", "true"),
+					func(s *terraform.State) error {
+						client, err := getOpenidClientFromState(s, "keycloak_openid_client.client")
+						if err != nil {
+							return err
+						}
+
+						if client.Attributes.IdTokenEncryptedResponseAlg != "RSA-OAEP" || client.Attributes.IdTokenEncryptedResponseEnc != "A256GCM" {
+							return fmt.Errorf("expected openid client to encrypt ID tokens with RSA-OAEP and A256GCM")
+						}
+
+						return nil
+					},
+				),
+			},
+			{
+				ResourceName:            "keycloak_openid_client.client",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateIdPrefix:     testAccRealm.Realm + "/",
+				ImportStateVerifyIgnore: []string{"exclude_session_state_from_auth_response"},
+			},
+			{
+				Config: testKeycloakOpenidClient_clientAuthenticatorType(clientId, "client-secret"),
+				Check:  resource.TestCheckResourceAttr("keycloak_openid_client.client", "token_security.0.access_token_signed_response_alg", "RS256"),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakOpenidClient_tokenSecurityWithoutActiveKey(t *testing.T) {
+	t.Parallel()
+	clientId := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakOpenidClientDestroy(),
+		Steps: []resource.TestStep{
+			{
+				// the test realm does not have an ECDSA key
+				Config:      testKeycloakOpenidClient_tokenSecurity(clientId, "ES512"),
+				ExpectError: regexp.MustCompile("the access token signing algorithm ES512 does not have an active key"),
+			},
+		},
+	})
+}
+
 func TestAccKeycloakOpenidClient_updateInPlace(t *testing.T) {
 	t.Parallel()
 	clientId := acctest.RandomWithPrefix("tf-acc")
@@ -405,6 +587,105 @@ func TestAccKeycloakOpenidClient_Device_basic(t *testing.T) {
 	})
 }
 
+func TestAccKeycloakOpenidClient_cibaAndPar(t *testing.T) {
+	if ok, _ := keycloakClient.VersionIsGreaterThanOrEqualTo(testCtx, keycloak.Version_13); !ok {
+		t.Skip()
+	}
+
+	t.Parallel()
+	clientId := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakOpenidClientDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakOpenidClient_cibaAndPar(clientId, "CONFIDENTIAL", "poll", ""),
+				Check:  testAccCheckKeycloakOpenidClientCibaAndPar("keycloak_openid_client.client", "poll", ""),
+			},
+			{
+				Config: testKeycloakOpenidClient_cibaAndPar(clientId, "CONFIDENTIAL", "ping", "https://example.com/ciba"),
+				Check:  testAccCheckKeycloakOpenidClientCibaAndPar("keycloak_openid_client.client", "ping", "https://example.com/ciba"),
+			},
+			{
+				Config: testKeycloakOpenidClient_cibaAndPar(clientId, "CONFIDENTIAL", "poll", ""),
+				Check:  testAccCheckKeycloakOpenidClientCibaAndPar("keycloak_openid_client.client", "poll", ""),
+			},
+			{
+				ResourceName:            "keycloak_openid_client.client",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateIdPrefix:     testAccRealm.Realm + "/",
+				ImportStateVerifyIgnore: []string{"exclude_session_state_from_auth_response"},
+			},
+		},
+	})
+}
+
+func TestAccKeycloakOpenidClient_cibaValidation(t *testing.T) {
+	t.Parallel()
+	clientId := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakOpenidClientDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config:      testKeycloakOpenidClient_cibaAndPar(clientId, "PUBLIC", "poll", ""),
+				ExpectError: regexp.MustCompile("validation error: the CIBA grant cannot be enabled on public clients"),
+			},
+			{
+				Config:      testKeycloakOpenidClient_cibaAndPar(clientId, "CONFIDENTIAL", "ping", ""),
+				ExpectError: regexp.MustCompile("validation error: the CIBA ping delivery mode requires a client notification endpoint"),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakOpenidClient_acrLoaMapping(t *testing.T) {
+	skipIfVersionIsLessThanOrEqualTo(testCtx, t, keycloakClient, keycloak.Version_16)
+
+	t.Parallel()
+	clientId := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakOpenidClientDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config:      testKeycloakOpenidClient_acrLoaMapping(clientId, "platinum"),
+				ExpectError: regexp.MustCompile("validation error: default ACR value platinum is not a level of authentication"),
+			},
+			{
+				Config: testKeycloakOpenidClient_acrLoaMapping(clientId, "gold"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("keycloak_openid_client.client", "acr_loa_mapping.gold", "2"),
+					resource.TestCheckResourceAttr("keycloak_openid_client.client", "default_acr_values.0", "gold"),
+					resource.TestCheckResourceAttr("keycloak_openid_client.client", "default_acr_values.1", "1"),
+					testAccCheckKeycloakOpenidClientExtraConfigMissing("keycloak_openid_client.client", keycloak.AcrLoaMapAttribute),
+				),
+			},
+			{
+				ResourceName:            "keycloak_openid_client.client",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateIdPrefix:     testAccRealm.Realm + "/",
+				ImportStateVerifyIgnore: []string{"exclude_session_state_from_auth_response"},
+			},
+			{
+				Config: testKeycloakOpenidClient_basic(clientId),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("keycloak_openid_client.client", "acr_loa_mapping.%", "0"),
+					resource.TestCheckResourceAttr("keycloak_openid_client.client", "default_acr_values.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccKeycloakOpenidClient_secret(t *testing.T) {
 	t.Parallel()
 	clientId := acctest.RandomWithPrefix("tf-acc")
@@ -923,6 +1204,33 @@ func testAccCheckKeycloakOpenidClientOauth2Device(resourceName string,
 	}
 }
 
+func testAccCheckKeycloakOpenidClientCibaAndPar(resourceName, deliveryMode, notificationEndpoint string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client, err := getOpenidClientFromState(s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		if !client.Attributes.CibaGrantEnabled {
+			return fmt.Errorf("expected openid client to have the CIBA grant enabled")
+		}
+
+		if client.Attributes.CibaBackchannelTokenDeliveryMode != deliveryMode {
+			return fmt.Errorf("expected openid client to have CIBA delivery mode set to %s, but got %s", deliveryMode, client.Attributes.CibaBackchannelTokenDeliveryMode)
+		}
+
+		if client.Attributes.CibaBackchannelClientNotificationEndpoint != notificationEndpoint {
+			return fmt.Errorf("expected openid client to have CIBA notification endpoint set to %s, but got %s", notificationEndpoint, client.Attributes.CibaBackchannelClientNotificationEndpoint)
+		}
+
+		if !client.Attributes.RequirePushedAuthorizationRequests {
+			return fmt.Errorf("expected openid client to require pushed authorization requests")
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckKeycloakOpenidClientFetch(resourceName string, client *keycloak.OpenidClient) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		fetchedClient, err := getOpenidClientFromState(s, resourceName)
@@ -1349,6 +1657,111 @@ resource "keycloak_openid_client" "client" {
 	`, testAccRealm.Realm, clientId, authType)
 }
 
+func testKeycloakOpenidClient_clientJwtJwksUrl(clientId, jwksUrl string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_openid_client" "client" {
+	realm_id                  = data.keycloak_realm.realm.id
+	client_id                 = "%s"
+	access_type               = "CONFIDENTIAL"
+	client_authenticator_type = "client-jwt"
+
+	client_jwt {
+		jwks_url    = "%s"
+		signing_alg = "RS256"
+	}
+}
+	`, testAccRealm.Realm, clientId, jwksUrl)
+}
+
+func testKeycloakOpenidClient_clientJwtCertificate(clientId, certificate string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_openid_client" "client" {
+	realm_id                  = data.keycloak_realm.realm.id
+	client_id                 = "%s"
+	access_type               = "CONFIDENTIAL"
+	client_authenticator_type = "client-jwt"
+
+	client_jwt {
+		certificate = "%s"
+		signing_alg = "RS256"
+	}
+}
+	`, testAccRealm.Realm, clientId, certificate)
+}
+
+func testKeycloakOpenidClient_clientX509(clientId, subjectDn string, allowRegexPatternComparison bool) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_openid_client" "client" {
+	realm_id                  = data.keycloak_realm.realm.id
+	client_id                 = "%s"
+	access_type               = "CONFIDENTIAL"
+	client_authenticator_type = "client-x509"
+
+	client_x509 {
+		subject_dn                     = "%s"
+		allow_regex_pattern_comparison = %t
+	}
+}
+	`, testAccRealm.Realm, clientId, subjectDn, allowRegexPatternComparison)
+}
+
+func testKeycloakOpenidClient_clientAuthenticatorBlockMismatch(clientId string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_openid_client" "client" {
+	realm_id                  = data.keycloak_realm.realm.id
+	client_id                 = "%s"
+	access_type               = "CONFIDENTIAL"
+	client_authenticator_type = "client-jwt"
+
+	client_x509 {
+		subject_dn = "CN=client"
+	}
+}
+	`, testAccRealm.Realm, clientId)
+}
+
+func testKeycloakOpenidClient_tokenSecurity(clientId, signingAlg string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_openid_client" "client" {
+	realm_id                  = data.keycloak_realm.realm.id
+	client_id                 = "%s"
+	access_type               = "CONFIDENTIAL"
+	client_authenticator_type = "client-secret"
+
+	token_security {
+		access_token_signed_response_alg           = "%s"
+		id_token_signed_response_alg               = "%s"
+		id_token_encrypted_response_alg            = "RSA-OAEP"
+		id_token_encrypted_response_enc            = "A256GCM"
+		user_info_response_signature_alg           = "unsigned"
+		request_object_signature_alg               = "PS256"
+		request_object_required                    = "request or request_uri"
+		tls_client_certificate_bound_access_tokens = true
+	}
+}
+	`, testAccRealm.Realm, clientId, signingAlg, signingAlg)
+}
+
 func testKeycloakOpenidClient_pkceChallengeMethod(clientId, pkceChallengeMethod string) string {
 
 	return fmt.Sprintf(`
@@ -1731,6 +2144,45 @@ resource "keycloak_openid_client" "client" {
 	`, testAccRealm.Realm, clientId, oauth2DeviceAuthorizationGrantEnabled, oauth2DeviceCodeLifespan, oauth2DevicePollingInterval)
 }
 
+func testKeycloakOpenidClient_cibaAndPar(clientId, accessType, deliveryMode, notificationEndpoint string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_openid_client" "client" {
+	client_id   = "%s"
+	realm_id    = data.keycloak_realm.realm.id
+	access_type = "%s"
+
+	ciba_grant_enabled                            = true
+	ciba_backchannel_token_delivery_mode          = "%s"
+	ciba_backchannel_client_notification_endpoint = "%s"
+	require_pushed_authorization_requests         = true
+}
+	`, testAccRealm.Realm, clientId, accessType, deliveryMode, notificationEndpoint)
+}
+
+func testKeycloakOpenidClient_acrLoaMapping(clientId, defaultAcrValue string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_openid_client" "client" {
+	client_id   = "%s"
+	realm_id    = data.keycloak_realm.realm.id
+	access_type = "CONFIDENTIAL"
+
+	acr_loa_mapping = {
+		silver = 1
+		gold   = 2
+	}
+	default_acr_values = ["%s", "1"]
+}
+	`, testAccRealm.Realm, clientId, defaultAcrValue)
+}
+
 func testKeycloakOpenidClient_import(clientId string, enabled bool) string {
 	return fmt.Sprintf(`
 data "keycloak_realm" "realm" {
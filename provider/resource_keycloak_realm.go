@@ -2,16 +2,21 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
 	"github.com/mrparkers/terraform-provider-keycloak/keycloak/types"
+	"strconv"
 )
 
 var (
 	keycloakRealmValidOTPTypes      = []string{"totp", "hotp"}
 	keycloakRealmValidOTPAlgorithms = []string{"HmacSHA1", "HmacSHA256", "HmacSHA512"}
+
+	keycloakRealmValidCibaBackchannelTokenDeliveryModes = []string{"poll", "ping"}
+	keycloakRealmValidCibaAuthRequestedUserHints        = []string{"login_hint"}
 )
 
 func resourceKeycloakRealm() *schema.Resource {
@@ -147,14 +152,21 @@ func resourceKeycloakRealm() *schema.Resource {
 		DeleteContext: resourceKeycloakRealmDelete,
 		UpdateContext: resourceKeycloakRealmUpdate,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourceKeycloakRealmImport,
 		},
+		CustomizeDiff: resourceKeycloakRealmCustomizeDiff,
 		Schema: map[string]*schema.Schema{
 			"realm": {
 				Type:     schema.TypeString,
 				Required: true,
 				ForceNew: true,
 			},
+			"deletion_protection": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "When true, the realm cannot be deleted or replaced. This must be set to false and applied before the realm can be destroyed.",
+			},
 			"internal_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -179,6 +191,11 @@ func resourceKeycloakRealm() *schema.Resource {
 				Optional: true,
 				Default:  false,
 			},
+			"admin_permissions_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
 
 			// Login Config
 			"registration_allowed": {
@@ -436,6 +453,63 @@ func resourceKeycloakRealm() *schema.Resource {
 				Computed: true,
 			},
 
+			// CIBA and PAR
+			"ciba_policy": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"backchannel_token_delivery_mode": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "poll",
+							ValidateFunc: validation.StringInSlice(keycloakRealmValidCibaBackchannelTokenDeliveryModes, false),
+						},
+						"expires_in": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Default:          "2m0s",
+							DiffSuppressFunc: suppressDurationStringDiff,
+						},
+						"interval": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Default:  5,
+						},
+						"auth_requested_user_hint": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "login_hint",
+							ValidateFunc: validation.StringInSlice(keycloakRealmValidCibaAuthRequestedUserHints, false),
+						},
+					},
+				},
+			},
+			"acr_loa_mapping": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+				Description: "Maps ACR values to levels of authentication, used for step-up authentication.",
+			},
+			"par_policy": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"request_uri_lifespan": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Default:          "1m0s",
+							DiffSuppressFunc: suppressDurationStringDiff,
+						},
+					},
+				},
+			},
+
 			// internationalization
 			"internationalization": {
 				Type:     schema.TypeList,
@@ -1014,8 +1088,53 @@ func getRealmFromData(data *schema.ResourceData) (*keycloak.Realm, error) {
 			attributes[key] = value
 		}
 	}
+
+	if v, ok := data.GetOk("ciba_policy"); ok {
+		cibaPolicy := v.([]interface{})[0].(map[string]interface{})
+
+		expiresIn, err := getSecondsFromDurationString(cibaPolicy["expires_in"].(string))
+		if err != nil {
+			return nil, err
+		}
+
+		attributes[keycloak.RealmAttributeCibaBackchannelTokenDeliveryMode] = cibaPolicy["backchannel_token_delivery_mode"].(string)
+		attributes[keycloak.RealmAttributeCibaExpiresIn] = strconv.Itoa(expiresIn)
+		attributes[keycloak.RealmAttributeCibaInterval] = strconv.Itoa(cibaPolicy["interval"].(int))
+		attributes[keycloak.RealmAttributeCibaAuthRequestedUserHint] = cibaPolicy["auth_requested_user_hint"].(string)
+	}
+
+	if v, ok := data.GetOk("par_policy"); ok {
+		parPolicy := v.([]interface{})[0].(map[string]interface{})
+
+		requestUriLifespan, err := getSecondsFromDurationString(parPolicy["request_uri_lifespan"].(string))
+		if err != nil {
+			return nil, err
+		}
+
+		attributes[keycloak.RealmAttributeParRequestUriLifespan] = strconv.Itoa(requestUriLifespan)
+	}
+
+	if v, ok := data.GetOk("acr_loa_mapping"); ok {
+		acrLoaMapping := map[string]int{}
+		for acr, level := range v.(map[string]interface{}) {
+			acrLoaMapping[acr] = level.(int)
+		}
+
+		acrLoaMap, err := keycloak.FormatAcrLoaMapping(acrLoaMapping)
+		if err != nil {
+			return nil, err
+		}
+
+		attributes[keycloak.AcrLoaMapAttribute] = acrLoaMap
+	}
+
 	realm.Attributes = attributes
 
+	if v, ok := data.GetOkExists("admin_permissions_enabled"); ok {
+		adminPermissionsEnabled := v.(bool)
+		realm.AdminPermissionsEnabled = &adminPermissionsEnabled
+	}
+
 	defaultDefaultClientScopes := make([]string, 0)
 	if v, ok := data.GetOk("default_default_client_scopes"); ok {
 		for _, defaultDefaultClientScope := range v.(*schema.Set).List() {
@@ -1180,6 +1299,10 @@ func setRealmData(data *schema.ResourceData, realm *keycloak.Realm) {
 	data.Set("display_name_html", realm.DisplayNameHtml)
 	data.Set("user_managed_access", realm.UserManagedAccess)
 
+	if realm.AdminPermissionsEnabled != nil {
+		data.Set("admin_permissions_enabled", *realm.AdminPermissionsEnabled)
+	}
+
 	// Login Config
 	data.Set("registration_allowed", realm.RegistrationAllowed)
 	data.Set("registration_email_as_username", realm.RegistrationEmailAsUsername)
@@ -1249,6 +1372,40 @@ func setRealmData(data *schema.ResourceData, realm *keycloak.Realm) {
 	data.Set("oauth2_device_code_lifespan", getDurationStringFromSeconds(realm.Oauth2DeviceCodeLifespan))
 	data.Set("oauth2_device_polling_interval", realm.Oauth2DevicePollingInterval)
 
+	if acrLoaMap, ok := realm.Attributes[keycloak.AcrLoaMapAttribute].(string); ok && acrLoaMap != "" {
+		acrLoaMapping, err := keycloak.ParseAcrLoaMapping(acrLoaMap)
+		if err == nil {
+			data.Set("acr_loa_mapping", acrLoaMapping)
+		}
+	} else {
+		data.Set("acr_loa_mapping", nil)
+	}
+
+	// CIBA and PAR
+	if deliveryMode, ok := realm.Attributes[keycloak.RealmAttributeCibaBackchannelTokenDeliveryMode]; ok {
+		expiresIn, _ := strconv.Atoi(fmt.Sprint(realm.Attributes[keycloak.RealmAttributeCibaExpiresIn]))
+		interval, _ := strconv.Atoi(fmt.Sprint(realm.Attributes[keycloak.RealmAttributeCibaInterval]))
+
+		cibaPolicy := make(map[string]interface{})
+		cibaPolicy["backchannel_token_delivery_mode"] = deliveryMode
+		cibaPolicy["expires_in"] = getDurationStringFromSeconds(expiresIn)
+		cibaPolicy["interval"] = interval
+		cibaPolicy["auth_requested_user_hint"] = realm.Attributes[keycloak.RealmAttributeCibaAuthRequestedUserHint]
+		data.Set("ciba_policy", []interface{}{cibaPolicy})
+	} else {
+		data.Set("ciba_policy", nil)
+	}
+
+	if requestUriLifespan, ok := realm.Attributes[keycloak.RealmAttributeParRequestUriLifespan]; ok {
+		lifespan, _ := strconv.Atoi(fmt.Sprint(requestUriLifespan))
+
+		parPolicy := make(map[string]interface{})
+		parPolicy["request_uri_lifespan"] = getDurationStringFromSeconds(lifespan)
+		data.Set("par_policy", []interface{}{parPolicy})
+	} else {
+		data.Set("par_policy", nil)
+	}
+
 	//internationalization
 	if realm.InternationalizationEnabled {
 		internationalizationSettings := make(map[string]interface{})
@@ -1374,6 +1531,8 @@ func resourceKeycloakRealmCreate(ctx context.Context, data *schema.ResourceData,
 		return diag.FromErr(err)
 	}
 
+	setOwnershipTagAttribute(keycloakClient, realm.Attributes)
+
 	err = keycloakClient.ValidateRealm(ctx, realm)
 	if err != nil {
 		return diag.FromErr(err)
@@ -1397,11 +1556,21 @@ func resourceKeycloakRealmRead(ctx context.Context, data *schema.ResourceData, m
 		return handleNotFoundError(ctx, err, data)
 	}
 
+	err = keycloakClient.ValidateOwnership(fmt.Sprintf("realm %s", realm.Realm), getOwnerFromAttributes(realm.Attributes))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	// we can't trust the API to set this field correctly since it just responds with "**********" this implies a 'password only' change will not detected
 	if smtpPassword, ok := getRealmSMTPPasswordFromData(data); ok {
 		realm.SmtpServer.Password = smtpPassword
 	}
 
+	// smtp settings that aren't managed by this resource may be managed by keycloak_realm_smtp_server instead
+	if _, ok := data.GetOk("smtp_server"); !ok {
+		realm.SmtpServer = keycloak.SmtpServer{}
+	}
+
 	setRealmData(data, realm)
 
 	return nil
@@ -1415,16 +1584,45 @@ func resourceKeycloakRealmUpdate(ctx context.Context, data *schema.ResourceData,
 		return diag.FromErr(err)
 	}
 
+	setOwnershipTagAttribute(keycloakClient, realm.Attributes)
+
 	err = keycloakClient.ValidateRealm(ctx, realm)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	err = keycloakClient.UpdateRealm(ctx, realm)
+	// keep smtp settings that are managed outside of this resource, i.e. by keycloak_realm_smtp_server
+	_, smtpServerManaged := data.GetOk("smtp_server")
+	if !smtpServerManaged && !data.HasChange("smtp_server") {
+		smtpServer, err := keycloakClient.GetRealmSmtpServer(ctx, data.Id())
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		realm.SmtpServer = *smtpServer
+	}
+
+	// attributes that were removed from the configuration are removed from the realm, all others are left alone
+	var removedAttributes []string
+	oldAttributes, newAttributes := data.GetChange("attributes")
+	for key := range oldAttributes.(map[string]interface{}) {
+		if _, ok := newAttributes.(map[string]interface{})[key]; !ok {
+			removedAttributes = append(removedAttributes, key)
+		}
+	}
+	if _, ok := data.GetOk("acr_loa_mapping"); !ok && data.HasChange("acr_loa_mapping") {
+		removedAttributes = append(removedAttributes, keycloak.AcrLoaMapAttribute)
+	}
+
+	err = keycloakClient.UpdateRealmRemovingAttributes(ctx, realm, removedAttributes)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
+	if !smtpServerManaged {
+		realm.SmtpServer = keycloak.SmtpServer{}
+	}
+
 	setRealmData(data, realm)
 
 	return nil
@@ -1433,5 +1631,49 @@ func resourceKeycloakRealmUpdate(ctx context.Context, data *schema.ResourceData,
 func resourceKeycloakRealmDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
+	if data.Get("deletion_protection").(bool) {
+		return diag.Errorf("realm %s cannot be deleted while deletion_protection is enabled, set it to false and apply before deleting the realm", data.Id())
+	}
+
+	realm, err := keycloakClient.GetRealm(ctx, data.Id())
+	if err != nil {
+		if keycloak.ErrorIs404(err) {
+			return nil
+		}
+
+		return diag.FromErr(err)
+	}
+
+	err = keycloakClient.ValidateOwnership(fmt.Sprintf("realm %s", realm.Realm), getOwnerFromAttributes(realm.Attributes))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	return diag.FromErr(keycloakClient.DeleteRealm(ctx, data.Id()))
 }
+
+func resourceKeycloakRealmImport(_ context.Context, data *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	data.Set("deletion_protection", false)
+
+	return []*schema.ResourceData{data}, nil
+}
+
+// Replacing a realm deletes it along with everything inside it, so deletion_protection covers replacement as well
+func resourceKeycloakRealmCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	if diff.Id() == "" {
+		return nil
+	}
+
+	deletionProtection, _ := diff.GetChange("deletion_protection")
+	if !deletionProtection.(bool) {
+		return nil
+	}
+
+	for _, key := range []string{"realm", "internal_id"} {
+		if diff.HasChange(key) {
+			return fmt.Errorf("realm %s cannot be replaced while deletion_protection is enabled, set it to false and apply before changing %s", diff.Id(), key)
+		}
+	}
+
+	return nil
+}
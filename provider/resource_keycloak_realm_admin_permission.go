@@ -0,0 +1,222 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+)
+
+func resourceKeycloakRealmAdminPermission() *schema.Resource {
+	var resourceTypes []string
+	for resourceType := range keycloak.AdminPermissionsResourceTypeScopes {
+		resourceTypes = append(resourceTypes, resourceType)
+	}
+	sort.Strings(resourceTypes)
+
+	return &schema.Resource{
+		CreateContext: resourceKeycloakRealmAdminPermissionCreate,
+		ReadContext:   resourceKeycloakRealmAdminPermissionRead,
+		DeleteContext: resourceKeycloakRealmAdminPermissionDelete,
+		UpdateContext: resourceKeycloakRealmAdminPermissionUpdate,
+		// This resource can be imported using {{realm}}/{{permissionId}}
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceKeycloakRealmAdminPermissionImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"resource_server_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Id of the admin-permissions client on which this permission is managed",
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"decision_strategy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(keycloakOpenidClientResourcePermissionDecisionStrategies, false),
+				Default:      "UNANIMOUS",
+			},
+			"resource_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(resourceTypes, false),
+			},
+			"resources": {
+				Type:        schema.TypeSet,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Optional:    true,
+				Description: "Ids of the users, groups, clients or roles this permission applies to. When empty, the permission applies to all of them",
+			},
+			"scopes": {
+				Type:     schema.TypeSet,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Required: true,
+			},
+			"policies": {
+				Type:     schema.TypeSet,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Optional: true,
+			},
+		},
+	}
+}
+
+func getRealmAdminPermissionFromData(data *schema.ResourceData) *keycloak.AdminPermission {
+	var resources []string
+	var scopes []string
+	var policies []string
+	if v, ok := data.GetOk("resources"); ok {
+		for _, resource := range v.(*schema.Set).List() {
+			resources = append(resources, resource.(string))
+		}
+	}
+	if v, ok := data.GetOk("scopes"); ok {
+		for _, scope := range v.(*schema.Set).List() {
+			scopes = append(scopes, scope.(string))
+		}
+	}
+	if v, ok := data.GetOk("policies"); ok {
+		for _, policy := range v.(*schema.Set).List() {
+			policies = append(policies, policy.(string))
+		}
+	}
+
+	return &keycloak.AdminPermission{
+		Id:               data.Id(),
+		RealmId:          data.Get("realm_id").(string),
+		ResourceServerId: data.Get("resource_server_id").(string),
+		Name:             data.Get("name").(string),
+		Description:      data.Get("description").(string),
+		DecisionStrategy: data.Get("decision_strategy").(string),
+		ResourceType:     data.Get("resource_type").(string),
+		Resources:        resources,
+		Scopes:           scopes,
+		Policies:         policies,
+	}
+}
+
+func setRealmAdminPermissionData(data *schema.ResourceData, permission *keycloak.AdminPermission) {
+	data.SetId(permission.Id)
+	data.Set("realm_id", permission.RealmId)
+	data.Set("resource_server_id", permission.ResourceServerId)
+	data.Set("name", permission.Name)
+	data.Set("description", permission.Description)
+	data.Set("decision_strategy", permission.DecisionStrategy)
+	data.Set("resource_type", permission.ResourceType)
+	data.Set("resources", permission.Resources)
+	data.Set("scopes", permission.Scopes)
+	data.Set("policies", permission.Policies)
+}
+
+func resourceKeycloakRealmAdminPermissionCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	permission := getRealmAdminPermissionFromData(data)
+
+	adminPermissionsClient, err := keycloakClient.GetAdminPermissionsClient(ctx, permission.RealmId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	permission.ResourceServerId = adminPermissionsClient.Id
+
+	err = keycloakClient.ValidateAdminPermission(ctx, permission)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = keycloakClient.NewAdminPermission(ctx, permission)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	setRealmAdminPermissionData(data, permission)
+
+	return resourceKeycloakRealmAdminPermissionRead(ctx, data, meta)
+}
+
+func resourceKeycloakRealmAdminPermissionRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	resourceServerId := data.Get("resource_server_id").(string)
+
+	permission, err := keycloakClient.GetAdminPermission(ctx, realmId, resourceServerId, data.Id())
+	if err != nil {
+		return handleNotFoundError(ctx, err, data)
+	}
+
+	setRealmAdminPermissionData(data, permission)
+
+	return nil
+}
+
+func resourceKeycloakRealmAdminPermissionUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	permission := getRealmAdminPermissionFromData(data)
+
+	err := keycloakClient.ValidateAdminPermission(ctx, permission)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = keycloakClient.UpdateAdminPermission(ctx, permission)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceKeycloakRealmAdminPermissionRead(ctx, data, meta)
+}
+
+func resourceKeycloakRealmAdminPermissionDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	resourceServerId := data.Get("resource_server_id").(string)
+
+	return diag.FromErr(keycloakClient.DeleteAdminPermission(ctx, realmId, resourceServerId, data.Id()))
+}
+
+func resourceKeycloakRealmAdminPermissionImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("Invalid import. Supported import formats: {{realmId}}/{{permissionId}}")
+	}
+
+	adminPermissionsClient, err := keycloakClient.GetAdminPermissionsClient(ctx, parts[0])
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("realm_id", parts[0])
+	d.Set("resource_server_id", adminPermissionsClient.Id)
+	d.SetId(parts[1])
+
+	diagnostics := resourceKeycloakRealmAdminPermissionRead(ctx, d, meta)
+	if diagnostics.HasError() {
+		return nil, errors.New(diagnostics[0].Summary)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,163 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+)
+
+func TestAccKeycloakRealmAdminPermission_basic(t *testing.T) {
+	skipIfVersionIsLessThan(testCtx, t, keycloakClient, keycloak.Version_26_2)
+
+	t.Parallel()
+	realmName := acctest.RandomWithPrefix("tf-acc")
+	username := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakRealmAdminPermissionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakRealmAdminPermission_basic(realmName, username, `["view"]`, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakRealmAdminPermissionExists("keycloak_realm_admin_permission.permission"),
+					resource.TestCheckResourceAttr("keycloak_realm_admin_permission.permission", "resources.#", "0"),
+					resource.TestCheckResourceAttr("keycloak_realm_admin_permission.permission", "scopes.#", "1"),
+				),
+			},
+			{
+				Config: testKeycloakRealmAdminPermission_basic(realmName, username, `["view", "manage"]`, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakRealmAdminPermissionExists("keycloak_realm_admin_permission.permission"),
+					resource.TestCheckResourceAttr("keycloak_realm_admin_permission.permission", "resources.#", "1"),
+					resource.TestCheckResourceAttr("keycloak_realm_admin_permission.permission", "scopes.#", "2"),
+				),
+			},
+			{
+				ResourceName:      "keycloak_realm_admin_permission.permission",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs := s.RootModule().Resources["keycloak_realm_admin_permission.permission"]
+					return fmt.Sprintf("%s/%s", realmName, rs.Primary.ID), nil
+				},
+			},
+		},
+	})
+}
+
+func TestAccKeycloakRealmAdminPermission_invalidScope(t *testing.T) {
+	skipIfVersionIsLessThan(testCtx, t, keycloakClient, keycloak.Version_26_2)
+
+	t.Parallel()
+	realmName := acctest.RandomWithPrefix("tf-acc")
+	username := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakRealmAdminPermissionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testKeycloakRealmAdminPermission_basic(realmName, username, `["view-members"]`, false),
+				ExpectError: regexp.MustCompile("scope view-members is not valid for resource type Users"),
+			},
+		},
+	})
+}
+
+func testAccCheckKeycloakRealmAdminPermissionExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		_, err := getRealmAdminPermissionFromState(s, resourceName)
+		return err
+	}
+}
+
+func testAccCheckKeycloakRealmAdminPermissionDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "keycloak_realm_admin_permission" {
+			continue
+		}
+
+		realmId := rs.Primary.Attributes["realm_id"]
+		resourceServerId := rs.Primary.Attributes["resource_server_id"]
+
+		permission, _ := keycloakClient.GetAdminPermission(testCtx, realmId, resourceServerId, rs.Primary.ID)
+		if permission != nil {
+			return fmt.Errorf("admin permission with id %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func getRealmAdminPermissionFromState(s *terraform.State, resourceName string) (*keycloak.AdminPermission, error) {
+	rs, ok := s.RootModule().Resources[resourceName]
+	if !ok {
+		return nil, fmt.Errorf("resource not found: %s", resourceName)
+	}
+
+	realmId := rs.Primary.Attributes["realm_id"]
+	resourceServerId := rs.Primary.Attributes["resource_server_id"]
+
+	permission, err := keycloakClient.GetAdminPermission(testCtx, realmId, resourceServerId, rs.Primary.ID)
+	if err != nil {
+		return nil, fmt.Errorf("error getting admin permission with id %s: %s", rs.Primary.ID, err)
+	}
+
+	return permission, nil
+}
+
+func testKeycloakRealmAdminPermission_basic(realmName, username, scopes string, withResources bool) string {
+	resources := ""
+	if withResources {
+		resources = "resources = [keycloak_user.user.id]"
+	}
+
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm                     = "%s"
+	admin_permissions_enabled = true
+}
+
+data "keycloak_openid_client" "admin_permissions" {
+	realm_id  = keycloak_realm.realm.id
+	client_id = "admin-permissions"
+}
+
+resource "keycloak_user" "user" {
+	realm_id = keycloak_realm.realm.id
+	username = "%s"
+}
+
+resource "keycloak_openid_client_user_policy" "policy" {
+	realm_id           = keycloak_realm.realm.id
+	resource_server_id = data.keycloak_openid_client.admin_permissions.id
+
+	name  = "admin_permission_user_policy"
+	users = [
+		keycloak_user.user.id
+	]
+
+	logic             = "POSITIVE"
+	decision_strategy = "UNANIMOUS"
+}
+
+resource "keycloak_realm_admin_permission" "permission" {
+	realm_id      = keycloak_realm.realm.id
+	name          = "users-permission"
+	description   = "manage users"
+	resource_type = "Users"
+	scopes        = %s
+	%s
+	policies      = [
+		keycloak_openid_client_user_policy.policy.id
+	]
+}`, realmName, username, scopes, resources)
+}
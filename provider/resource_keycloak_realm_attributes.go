@@ -0,0 +1,116 @@
+package provider
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+)
+
+func resourceKeycloakRealmAttributes() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceKeycloakRealmAttributesCreate,
+		ReadContext:   resourceKeycloakRealmAttributesRead,
+		DeleteContext: resourceKeycloakRealmAttributesDelete,
+		UpdateContext: resourceKeycloakRealmAttributesUpdate,
+		Schema: map[string]*schema.Schema{
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"attributes": {
+				Type:        schema.TypeMap,
+				Required:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The realm attributes managed by this resource. Attributes that are not listed here are left untouched.",
+			},
+		},
+	}
+}
+
+func getRealmAttributesFromData(data *schema.ResourceData) map[string]string {
+	attributes := map[string]string{}
+	for key, value := range data.Get("attributes").(map[string]interface{}) {
+		attributes[key] = value.(string)
+	}
+
+	return attributes
+}
+
+func resourceKeycloakRealmAttributesCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+
+	err := keycloakClient.UpdateRealmAttributes(ctx, realmId, getRealmAttributesFromData(data), nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data.SetId(realmId)
+
+	return resourceKeycloakRealmAttributesRead(ctx, data, meta)
+}
+
+func resourceKeycloakRealmAttributesRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+
+	realmAttributes, err := keycloakClient.GetRealmAttributes(ctx, realmId)
+	if err != nil {
+		return handleNotFoundError(ctx, err, data)
+	}
+
+	// only attributes managed by this resource are tracked, missing ones will show up as a diff
+	attributes := map[string]string{}
+	for key := range data.Get("attributes").(map[string]interface{}) {
+		if value, ok := realmAttributes[key]; ok {
+			attributes[key] = value
+		}
+	}
+
+	data.Set("attributes", attributes)
+
+	return nil
+}
+
+func resourceKeycloakRealmAttributesUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+
+	var removedAttributes []string
+	oldAttributes, newAttributes := data.GetChange("attributes")
+	for key := range oldAttributes.(map[string]interface{}) {
+		if _, ok := newAttributes.(map[string]interface{})[key]; !ok {
+			removedAttributes = append(removedAttributes, key)
+		}
+	}
+
+	err := keycloakClient.UpdateRealmAttributes(ctx, realmId, getRealmAttributesFromData(data), removedAttributes)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceKeycloakRealmAttributesRead(ctx, data, meta)
+}
+
+func resourceKeycloakRealmAttributesDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+
+	var removedAttributes []string
+	for key := range data.Get("attributes").(map[string]interface{}) {
+		removedAttributes = append(removedAttributes, key)
+	}
+
+	err := keycloakClient.UpdateRealmAttributes(ctx, realmId, nil, removedAttributes)
+	if err != nil && !keycloak.ErrorIs404(err) {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
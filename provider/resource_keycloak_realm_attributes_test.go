@@ -0,0 +1,166 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"testing"
+)
+
+func TestAccKeycloakRealmAttributes_basic(t *testing.T) {
+	realmName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakRealmAttributes_basic(realmName, "bar"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakRealmAttribute(realmName, "foo", "bar"),
+					testAccCheckKeycloakRealmAttribute(realmName, "frontendUrl", "https://sso.example.com"),
+				),
+			},
+			{
+				Config: testKeycloakRealmAttributes_basic(realmName, "baz"),
+				Check:  testAccCheckKeycloakRealmAttribute(realmName, "foo", "baz"),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakRealmAttributes_removeAttribute(t *testing.T) {
+	realmName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakRealmAttributes_basic(realmName, "bar"),
+				Check:  testAccCheckKeycloakRealmAttribute(realmName, "foo", "bar"),
+			},
+			{
+				Config: testKeycloakRealmAttributes_single(realmName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakRealmAttributeMissing(realmName, "foo"),
+					testAccCheckKeycloakRealmAttribute(realmName, "frontendUrl", "https://sso.example.com"),
+				),
+			},
+			{
+				Config: testKeycloakRealmEvents_realmOnly(realmName),
+				Check:  testAccCheckKeycloakRealmAttributeMissing(realmName, "frontendUrl"),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakRealmAttributes_realmUpdateKeepsAttributes(t *testing.T) {
+	realmName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakRealmAttributes_withRealmDisplayName(realmName, "foo"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakRealmAttribute(realmName, "foo", "bar"),
+					testAccCheckKeycloakRealmAttribute(realmName, "managed", "by-realm"),
+				),
+			},
+			{
+				Config: testKeycloakRealmAttributes_withRealmDisplayName(realmName, "bar"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakRealmAttribute(realmName, "foo", "bar"),
+					testAccCheckKeycloakRealmAttribute(realmName, "managed", "by-realm"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckKeycloakRealmAttribute(realm, key, value string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		attributes, err := keycloakClient.GetRealmAttributes(testCtx, realm)
+		if err != nil {
+			return err
+		}
+
+		if attributes[key] != value {
+			return fmt.Errorf("expected realm attribute %s to be %s, but was %s", key, value, attributes[key])
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckKeycloakRealmAttributeMissing(realm, key string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		attributes, err := keycloakClient.GetRealmAttributes(testCtx, realm)
+		if err != nil {
+			return err
+		}
+
+		if value, ok := attributes[key]; ok && value != "" {
+			return fmt.Errorf("expected realm attribute %s to be removed, but was %s", key, value)
+		}
+
+		return nil
+	}
+}
+
+func testKeycloakRealmAttributes_basic(realm, value string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_realm_attributes" "attributes" {
+	realm_id = keycloak_realm.realm.id
+
+	attributes = {
+		foo         = "%s"
+		frontendUrl = "https://sso.example.com"
+	}
+}
+	`, realm, value)
+}
+
+func testKeycloakRealmAttributes_single(realm string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_realm_attributes" "attributes" {
+	realm_id = keycloak_realm.realm.id
+
+	attributes = {
+		frontendUrl = "https://sso.example.com"
+	}
+}
+	`, realm)
+}
+
+func testKeycloakRealmAttributes_withRealmDisplayName(realm, displayName string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm        = "%s"
+	display_name = "%s"
+
+	attributes = {
+		managed = "by-realm"
+	}
+}
+
+resource "keycloak_realm_attributes" "attributes" {
+	realm_id = keycloak_realm.realm.id
+
+	attributes = {
+		foo = "bar"
+	}
+}
+	`, realm, displayName)
+}
@@ -0,0 +1,214 @@
+package provider
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak/types"
+)
+
+func resourceKeycloakRealmSmtpServer() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceKeycloakRealmSmtpServerCreate,
+		ReadContext:   resourceKeycloakRealmSmtpServerRead,
+		DeleteContext: resourceKeycloakRealmSmtpServerDelete,
+		UpdateContext: resourceKeycloakRealmSmtpServerUpdate,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceKeycloakRealmSmtpServerImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"host": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"port": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"from": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"from_display_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"reply_to": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"reply_to_display_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"envelope_from": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"starttls": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"ssl": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"auth": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"username": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"password": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Sensitive:   true,
+							Description: "The SMTP server password. Keycloak never returns this value, so it is only ever written to the server.",
+						},
+					},
+				},
+			},
+			"test_connection": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "When true, the settings are verified by sending a test email before they are saved.",
+			},
+		},
+	}
+}
+
+func getRealmSmtpServerFromData(data *schema.ResourceData) *keycloak.SmtpServer {
+	smtpServer := &keycloak.SmtpServer{
+		StartTls:           types.KeycloakBoolQuoted(data.Get("starttls").(bool)),
+		Port:               data.Get("port").(string),
+		Host:               data.Get("host").(string),
+		ReplyTo:            data.Get("reply_to").(string),
+		ReplyToDisplayName: data.Get("reply_to_display_name").(string),
+		From:               data.Get("from").(string),
+		FromDisplayName:    data.Get("from_display_name").(string),
+		EnvelopeFrom:       data.Get("envelope_from").(string),
+		Ssl:                types.KeycloakBoolQuoted(data.Get("ssl").(bool)),
+	}
+
+	if v, ok := data.GetOk("auth"); ok {
+		auth := v.([]interface{})[0].(map[string]interface{})
+
+		smtpServer.Auth = true
+		smtpServer.User = auth["username"].(string)
+		smtpServer.Password = auth["password"].(string)
+	}
+
+	return smtpServer
+}
+
+func setRealmSmtpServerData(data *schema.ResourceData, smtpServer *keycloak.SmtpServer) {
+	data.Set("starttls", bool(smtpServer.StartTls))
+	data.Set("port", smtpServer.Port)
+	data.Set("host", smtpServer.Host)
+	data.Set("reply_to", smtpServer.ReplyTo)
+	data.Set("reply_to_display_name", smtpServer.ReplyToDisplayName)
+	data.Set("from", smtpServer.From)
+	data.Set("from_display_name", smtpServer.FromDisplayName)
+	data.Set("envelope_from", smtpServer.EnvelopeFrom)
+	data.Set("ssl", bool(smtpServer.Ssl))
+
+	if smtpServer.Auth {
+		auth := map[string]interface{}{
+			"username": smtpServer.User,
+			// the API responds with "**********", so the password is always taken from the existing state
+			"password": "",
+		}
+
+		if v, ok := data.GetOk("auth"); ok {
+			auth["password"] = v.([]interface{})[0].(map[string]interface{})["password"]
+		}
+
+		data.Set("auth", []interface{}{auth})
+	} else {
+		data.Set("auth", nil)
+	}
+}
+
+func resourceKeycloakRealmSmtpServerCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	realmId := data.Get("realm_id").(string)
+	data.SetId(realmId)
+
+	diagnostics := resourceKeycloakRealmSmtpServerUpdate(ctx, data, meta)
+	if diagnostics.HasError() {
+		data.SetId("")
+		return diagnostics
+	}
+
+	return resourceKeycloakRealmSmtpServerRead(ctx, data, meta)
+}
+
+func resourceKeycloakRealmSmtpServerRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+
+	smtpServer, err := keycloakClient.GetRealmSmtpServer(ctx, realmId)
+	if err != nil {
+		return handleNotFoundError(ctx, err, data)
+	}
+
+	if (keycloak.SmtpServer{}) == *smtpServer {
+		// the smtp settings were removed outside of terraform
+		data.SetId("")
+		return nil
+	}
+
+	setRealmSmtpServerData(data, smtpServer)
+
+	return nil
+}
+
+func resourceKeycloakRealmSmtpServerUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	smtpServer := getRealmSmtpServerFromData(data)
+
+	if data.Get("test_connection").(bool) {
+		err := keycloakClient.TestRealmSmtpConnection(ctx, realmId, smtpServer)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	err := keycloakClient.UpdateRealmSmtpServer(ctx, realmId, smtpServer)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceKeycloakRealmSmtpServerDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+
+	// smtp settings cannot be deleted, so instead they are set back to their "zero" values.
+	return diag.FromErr(keycloakClient.UpdateRealmSmtpServer(ctx, realmId, &keycloak.SmtpServer{}))
+}
+
+func resourceKeycloakRealmSmtpServerImport(_ context.Context, data *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	data.Set("realm_id", data.Id())
+	data.Set("test_connection", false)
+
+	return []*schema.ResourceData{data}, nil
+}
@@ -0,0 +1,212 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+	"regexp"
+	"testing"
+)
+
+func TestAccKeycloakRealmSmtpServer_basic(t *testing.T) {
+	realmName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakRealmSmtpServer_basic(realmName, "myhost.com", "user", "password"),
+				Check:  testAccCheckKeycloakRealmSmtpServer("keycloak_realm_smtp_server.smtp", "myhost.com", "user"),
+			},
+			{
+				ResourceName:            "keycloak_realm_smtp_server.smtp",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"auth.0.password"},
+			},
+		},
+	})
+}
+
+func TestAccKeycloakRealmSmtpServer_update(t *testing.T) {
+	realmName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakRealmSmtpServer_basic(realmName, "myhost.com", "user", "password"),
+				Check:  testAccCheckKeycloakRealmSmtpServer("keycloak_realm_smtp_server.smtp", "myhost.com", "user"),
+			},
+			{
+				Config: testKeycloakRealmSmtpServer_basic(realmName, "myhost2.com", "user2", "password2"),
+				Check:  testAccCheckKeycloakRealmSmtpServer("keycloak_realm_smtp_server.smtp", "myhost2.com", "user2"),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakRealmSmtpServer_destroy(t *testing.T) {
+	realmName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakRealmSmtpServer_basic(realmName, "myhost.com", "user", "password"),
+				Check:  testAccCheckKeycloakRealmSmtpServer("keycloak_realm_smtp_server.smtp", "myhost.com", "user"),
+			},
+			{
+				Config: testKeycloakRealmEvents_realmOnly(realmName),
+				Check: func(state *terraform.State) error {
+					smtpServer, err := keycloakClient.GetRealmSmtpServer(testCtx, realmName)
+					if err != nil {
+						return err
+					}
+
+					if smtpServer.Host != "" {
+						return fmt.Errorf("expected smtp host to be empty after destroy, but was %s", smtpServer.Host)
+					}
+
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func TestAccKeycloakRealmSmtpServer_realmUpdateKeepsSmtpServer(t *testing.T) {
+	realmName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakRealmSmtpServer_withRealmDisplayName(realmName, "foo"),
+				Check:  testAccCheckKeycloakRealmSmtpServer("keycloak_realm_smtp_server.smtp", "myhost.com", "user"),
+			},
+			{
+				Config: testKeycloakRealmSmtpServer_withRealmDisplayName(realmName, "bar"),
+				Check:  testAccCheckKeycloakRealmSmtpServer("keycloak_realm_smtp_server.smtp", "myhost.com", "user"),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakRealmSmtpServer_testConnectionFails(t *testing.T) {
+	realmName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config:      testKeycloakRealmSmtpServer_testConnection(realmName),
+				ExpectError: regexp.MustCompile("smtp connection test failed"),
+			},
+		},
+	})
+}
+
+func testAccCheckKeycloakRealmSmtpServer(resourceName, host, user string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		smtpServer, err := getRealmSmtpServerFromState(s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		if smtpServer.Host != host {
+			return fmt.Errorf("expected smtp host to be %s, but was %s", host, smtpServer.Host)
+		}
+
+		if smtpServer.User != user {
+			return fmt.Errorf("expected smtp user to be %s, but was %s", user, smtpServer.User)
+		}
+
+		return nil
+	}
+}
+
+func getRealmSmtpServerFromState(s *terraform.State, resourceName string) (*keycloak.SmtpServer, error) {
+	rs, ok := s.RootModule().Resources[resourceName]
+	if !ok {
+		return nil, fmt.Errorf("resource not found: %s", resourceName)
+	}
+
+	realm := rs.Primary.Attributes["realm_id"]
+
+	smtpServer, err := keycloakClient.GetRealmSmtpServer(testCtx, realm)
+	if err != nil {
+		return nil, fmt.Errorf("error getting realm smtp server: %s", err)
+	}
+
+	return smtpServer, nil
+}
+
+func testKeycloakRealmSmtpServer_basic(realm, host, user, password string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_realm_smtp_server" "smtp" {
+	realm_id = keycloak_realm.realm.id
+
+	host              = "%s"
+	port              = 25
+	from              = "tom@myhost.com"
+	from_display_name = "Tom"
+	starttls          = true
+
+	auth {
+		username = "%s"
+		password = "%s"
+	}
+}
+	`, realm, host, user, password)
+}
+
+func testKeycloakRealmSmtpServer_withRealmDisplayName(realm, displayName string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm        = "%s"
+	display_name = "%s"
+}
+
+resource "keycloak_realm_smtp_server" "smtp" {
+	realm_id = keycloak_realm.realm.id
+
+	host = "myhost.com"
+	from = "tom@myhost.com"
+
+	auth {
+		username = "user"
+		password = "password"
+	}
+}
+	`, realm, displayName)
+}
+
+func testKeycloakRealmSmtpServer_testConnection(realm string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_realm_smtp_server" "smtp" {
+	realm_id = keycloak_realm.realm.id
+
+	host = "localhost"
+	port = 1
+	from = "tom@myhost.com"
+
+	test_connection = true
+}
+	`, realm)
+}
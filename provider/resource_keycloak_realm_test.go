@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
 	"regexp"
@@ -506,6 +507,97 @@ func TestAccKeycloakRealm_oauth2DeviceSettings(t *testing.T) {
 	})
 }
 
+func TestAccKeycloakRealm_cibaAndParPolicy(t *testing.T) {
+	if ok, _ := keycloakClient.VersionIsGreaterThanOrEqualTo(testCtx, keycloak.Version_13); !ok {
+		t.Skip()
+	}
+
+	realmName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakRealmDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakRealm_cibaAndParPolicy(realmName, "poll", "3m0s", 10, "2m0s"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakRealmExists("keycloak_realm.realm"),
+					resource.TestCheckResourceAttr("keycloak_realm.realm", "ciba_policy.0.backchannel_token_delivery_mode", "poll"),
+					resource.TestCheckResourceAttr("keycloak_realm.realm", "ciba_policy.0.expires_in", "3m0s"),
+					resource.TestCheckResourceAttr("keycloak_realm.realm", "ciba_policy.0.interval", "10"),
+					resource.TestCheckResourceAttr("keycloak_realm.realm", "par_policy.0.request_uri_lifespan", "2m0s"),
+					testAccCheckKeycloakRealmAttribute(realmName, keycloak.RealmAttributeCibaExpiresIn, "180"),
+					testAccCheckKeycloakRealmAttribute(realmName, keycloak.RealmAttributeParRequestUriLifespan, "120"),
+				),
+			},
+			{
+				Config: testKeycloakRealm_cibaAndParPolicy(realmName, "ping", "1m0s", 2, "30s"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("keycloak_realm.realm", "ciba_policy.0.backchannel_token_delivery_mode", "ping"),
+					testAccCheckKeycloakRealmAttribute(realmName, keycloak.RealmAttributeCibaInterval, "2"),
+					testAccCheckKeycloakRealmAttribute(realmName, keycloak.RealmAttributeParRequestUriLifespan, "30"),
+				),
+			},
+			{
+				ResourceName:      "keycloak_realm.realm",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccKeycloakRealm_acrLoaMapping(t *testing.T) {
+	skipIfVersionIsLessThanOrEqualTo(testCtx, t, keycloakClient, keycloak.Version_16)
+
+	realmName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakRealmDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakRealm_acrLoaMapping(realmName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("keycloak_realm.realm", "acr_loa_mapping.silver", "1"),
+					resource.TestCheckResourceAttr("keycloak_realm.realm", "acr_loa_mapping.gold", "2"),
+					testAccCheckKeycloakRealmAttribute(realmName, keycloak.AcrLoaMapAttribute, `{"gold":2,"silver":1}`),
+				),
+			},
+			{
+				ResourceName:      "keycloak_realm.realm",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testKeycloakRealm_basic(realmName, realmName, realmName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckNoResourceAttr("keycloak_realm.realm", "acr_loa_mapping.silver"),
+					testAccCheckKeycloakRealmAttributeMissing(realmName, keycloak.AcrLoaMapAttribute),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakRealm_cibaPolicyValidation(t *testing.T) {
+	realmName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakRealmDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config:      testKeycloakRealm_cibaAndParPolicy(realmName, "push", "3m0s", 10, "2m0s"),
+				ExpectError: regexp.MustCompile("expected ciba_policy.0.backchannel_token_delivery_mode to be one of"),
+			},
+		},
+	})
+}
+
 func TestAccKeycloakRealm_securityDefensesHeaders(t *testing.T) {
 	realmName := acctest.RandomWithPrefix("tf-acc")
 	realmDisplayName := acctest.RandomWithPrefix("tf-acc")
@@ -1022,6 +1114,72 @@ func testKeycloakRealmLoginInfo(resourceName string, realm *keycloak.Realm) reso
 	}
 }
 
+func TestAccKeycloakRealm_deletionProtection(t *testing.T) {
+	realmName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakRealmDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakRealm_deletionProtection(realmName, true),
+				Check:  testAccCheckKeycloakRealmExists("keycloak_realm.realm"),
+			},
+			{
+				Config:      testKeycloakRealm_deletionProtection(realmName+"-renamed", true),
+				ExpectError: regexp.MustCompile("cannot be replaced while deletion_protection is enabled"),
+			},
+			{
+				Config:      testKeycloakRealm_deletionProtection(realmName, true),
+				Destroy:     true,
+				ExpectError: regexp.MustCompile("cannot be deleted while deletion_protection is enabled"),
+			},
+			{
+				Config: testKeycloakRealm_deletionProtection(realmName, false),
+				Check:  testAccCheckKeycloakRealmExists("keycloak_realm.realm"),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakRealm_ownershipTag(t *testing.T) {
+	realmName := acctest.RandomWithPrefix("tf-acc")
+
+	err := keycloakClient.NewRealm(testCtx, &keycloak.Realm{
+		Realm:   realmName,
+		Enabled: true,
+		Attributes: map[string]interface{}{
+			keycloak.OwnershipTagAttribute: "another-workspace",
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer keycloakClient.DeleteRealm(testCtx, realmName)
+
+	provider := KeycloakProvider(nil)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: map[string]func() (*schema.Provider, error){
+			"keycloak": func() (*schema.Provider, error) {
+				return provider, nil
+			},
+		},
+		PreCheck: func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config:        testKeycloakRealm_ownershipTag(realmName, "this-workspace"),
+				ResourceName:  "keycloak_realm.realm",
+				ImportState:   true,
+				ImportStateId: realmName,
+				ExpectError:   regexp.MustCompile("is owned by another Terraform workspace"),
+			},
+		},
+	})
+}
+
 func testAccCheckKeycloakRealmExists(resourceName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		_, err := getRealmFromState(s, resourceName)
@@ -1746,3 +1904,60 @@ resource "keycloak_realm" "realm" {
 }
 	`, realm, internalId)
 }
+
+func testKeycloakRealm_deletionProtection(realm string, deletionProtection bool) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm   = "%s"
+	enabled = true
+
+	deletion_protection = %t
+}
+	`, realm, deletionProtection)
+}
+
+func testKeycloakRealm_ownershipTag(realm, ownershipTag string) string {
+	return fmt.Sprintf(`
+provider "keycloak" {
+	ownership_tag = "%s"
+}
+
+resource "keycloak_realm" "realm" {
+	realm   = "%s"
+	enabled = true
+}
+	`, ownershipTag, realm)
+}
+
+func testKeycloakRealm_cibaAndParPolicy(realm, deliveryMode, expiresIn string, interval int, requestUriLifespan string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm   = "%s"
+	enabled = true
+
+	ciba_policy {
+		backchannel_token_delivery_mode = "%s"
+		expires_in                      = "%s"
+		interval                        = %d
+	}
+
+	par_policy {
+		request_uri_lifespan = "%s"
+	}
+}
+	`, realm, deliveryMode, expiresIn, interval, requestUriLifespan)
+}
+
+func testKeycloakRealm_acrLoaMapping(realm string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm   = "%s"
+	enabled = true
+
+	acr_loa_mapping = {
+		silver = 1
+		gold   = 2
+	}
+}
+	`, realm)
+}
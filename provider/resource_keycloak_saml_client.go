@@ -28,6 +28,24 @@ var (
 		"INCLUSIVE":               "http://www.w3.org/TR/2001/REC-xml-c14n-20010315",
 		"INCLUSIVE_WITH_COMMENTS": "http://www.w3.org/TR/2001/REC-xml-c14n-20010315#WithComments",
 	}
+	keycloakSamlClientEncryptionAlgorithms = []string{
+		"http://www.w3.org/2009/xmlenc11#aes256-gcm",
+		"http://www.w3.org/2009/xmlenc11#aes192-gcm",
+		"http://www.w3.org/2009/xmlenc11#aes128-gcm",
+		"http://www.w3.org/2001/04/xmlenc#aes256-cbc",
+		"http://www.w3.org/2001/04/xmlenc#aes192-cbc",
+		"http://www.w3.org/2001/04/xmlenc#aes128-cbc",
+	}
+	keycloakSamlClientEncryptionKeyAlgorithms = []string{
+		"http://www.w3.org/2009/xmlenc11#rsa-oaep",
+		"http://www.w3.org/2001/04/xmlenc#rsa-oaep-mgf1p",
+		"http://www.w3.org/2001/04/xmlenc#rsa-1_5",
+	}
+	keycloakSamlClientEncryptionDigestMethods = []string{
+		"http://www.w3.org/2000/09/xmldsig#sha1",
+		"http://www.w3.org/2001/04/xmlenc#sha256",
+		"http://www.w3.org/2001/04/xmlenc#sha512",
+	}
 )
 
 func resourceKeycloakSamlClient() *schema.Resource {
@@ -36,6 +54,7 @@ func resourceKeycloakSamlClient() *schema.Resource {
 		ReadContext:   resourceKeycloakSamlClientRead,
 		DeleteContext: resourceKeycloakSamlClientDelete,
 		UpdateContext: resourceKeycloakSamlClientUpdate,
+		CustomizeDiff: resourceKeycloakSamlClientCustomizeDiff,
 		// This resource can be imported using {{realm}}/{{client_id}}. The Client ID is displayed in the GUI
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceKeycloakSamlClientImport,
@@ -193,21 +212,81 @@ func resourceKeycloakSamlClient() *schema.Resource {
 				Optional: true,
 			},
 			"assertion_consumer_post_url": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				DiffSuppressFunc: suppressSamlClientMetadataDiff,
+			},
+			"assertion_consumer_redirect_url": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				DiffSuppressFunc: suppressSamlClientMetadataDiff,
+			},
+			"logout_service_post_binding_url": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				DiffSuppressFunc: suppressSamlClientMetadataDiff,
+			},
+			"logout_service_redirect_binding_url": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				DiffSuppressFunc: suppressSamlClientMetadataDiff,
+			},
+			"logout_service_artifact_binding_url": {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
-			"assertion_consumer_redirect_url": {
+			"logout_service_soap_binding_url": {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
-			"logout_service_post_binding_url": {
+			"artifact_binding": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"artifact_binding_url": {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
-			"logout_service_redirect_binding_url": {
+			"artifact_resolution_service_url": {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"allow_ecp_flow": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"encryption_algorithm": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(keycloakSamlClientEncryptionAlgorithms, false),
+			},
+			"encryption_key_algorithm": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(keycloakSamlClientEncryptionKeyAlgorithms, false),
+			},
+			"encryption_digest_method": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(keycloakSamlClientEncryptionDigestMethods, false),
+			},
+			"metadata_xml": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"metadata_url"},
+			},
+			"metadata_url": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ValidateFunc:  validation.IsURLWithHTTPorHTTPS,
+				ConflictsWith: []string{"metadata_xml"},
+			},
+			"metadata_sha1": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"full_scope_allowed": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -287,6 +366,15 @@ func mapToSamlClientFromData(data *schema.ResourceData) *keycloak.SamlClient {
 		LogoutServicePostBindingURL:     data.Get("logout_service_post_binding_url").(string),
 		LogoutServiceRedirectBindingURL: data.Get("logout_service_redirect_binding_url").(string),
 		LoginTheme:                      data.Get("login_theme").(string),
+		ArtifactBinding:                 types.KeycloakBoolQuoted(data.Get("artifact_binding").(bool)),
+		ArtifactBindingURL:              data.Get("artifact_binding_url").(string),
+		ArtifactResolutionServiceURL:    data.Get("artifact_resolution_service_url").(string),
+		LogoutServiceArtifactBindingURL: data.Get("logout_service_artifact_binding_url").(string),
+		LogoutServiceSoapBindingURL:     data.Get("logout_service_soap_binding_url").(string),
+		AllowEcpFlow:                    types.KeycloakBoolQuoted(data.Get("allow_ecp_flow").(bool)),
+		EncryptionAlgorithm:             data.Get("encryption_algorithm").(string),
+		EncryptionKeyAlgorithm:          data.Get("encryption_key_algorithm").(string),
+		EncryptionDigestMethod:          data.Get("encryption_digest_method").(string),
 		ExtraConfig:                     getExtraConfigFromData(data),
 	}
 
@@ -371,6 +459,15 @@ func mapToDataFromSamlClient(ctx context.Context, data *schema.ResourceData, cli
 	data.Set("logout_service_redirect_binding_url", client.Attributes.LogoutServiceRedirectBindingURL)
 	data.Set("full_scope_allowed", client.FullScopeAllowed)
 	data.Set("login_theme", client.Attributes.LoginTheme)
+	data.Set("artifact_binding", client.Attributes.ArtifactBinding)
+	data.Set("artifact_binding_url", client.Attributes.ArtifactBindingURL)
+	data.Set("artifact_resolution_service_url", client.Attributes.ArtifactResolutionServiceURL)
+	data.Set("logout_service_artifact_binding_url", client.Attributes.LogoutServiceArtifactBindingURL)
+	data.Set("logout_service_soap_binding_url", client.Attributes.LogoutServiceSoapBindingURL)
+	data.Set("allow_ecp_flow", client.Attributes.AllowEcpFlow)
+	data.Set("encryption_algorithm", client.Attributes.EncryptionAlgorithm)
+	data.Set("encryption_key_algorithm", client.Attributes.EncryptionKeyAlgorithm)
+	data.Set("encryption_digest_method", client.Attributes.EncryptionDigestMethod)
 
 	if canonicalizationMethod, ok := mapKeyFromValue(keycloakSamlClientCanonicalizationMethods, client.Attributes.CanonicalizationMethod); ok {
 		data.Set("canonicalization_method", canonicalizationMethod)
@@ -410,12 +507,151 @@ func resourceKeycloakSamlClientSetSha1(ctx context.Context, data *schema.Resourc
 	}
 }
 
+// the urls of a client that is created from metadata come from the metadata, unless they are given
+func suppressSamlClientMetadataDiff(_, _, new string, d *schema.ResourceData) bool {
+	return new == "" && (d.Get("metadata_xml").(string) != "" || d.Get("metadata_url").(string) != "")
+}
+
+// getSamlClientMetadata returns the metadata given in metadata_xml or downloaded from metadata_url, if any
+func getSamlClientMetadata(ctx context.Context, keycloakClient *keycloak.KeycloakClient, metadataXml, metadataUrl string) (string, error) {
+	if metadataUrl != "" {
+		return keycloakClient.GetSamlClientMetadata(ctx, metadataUrl)
+	}
+
+	return metadataXml, nil
+}
+
+func samlClientMetadataSha1(metadata string) string {
+	if metadata == "" {
+		return ""
+	}
+
+	hash := sha1.Sum([]byte(metadata))
+
+	return hex.EncodeToString(hash[:])
+}
+
+// applySamlClientMetadata fills the certificates and the service urls of the client from its metadata. Values that are given in
+// the configuration take precedence over the metadata.
+func applySamlClientMetadata(ctx context.Context, keycloakClient *keycloak.KeycloakClient, data *schema.ResourceData, client *keycloak.SamlClient) error {
+	metadataUrl := data.Get("metadata_url").(string)
+
+	metadata, err := getSamlClientMetadata(ctx, keycloakClient, data.Get("metadata_xml").(string), metadataUrl)
+	if err != nil {
+		return err
+	}
+
+	// metadata_url is downloaded again during apply, which must not apply a document other than the one that was planned
+	metadataSha1 := samlClientMetadataSha1(metadata)
+	if plannedMetadataSha1 := data.Get("metadata_sha1").(string); metadataUrl != "" && plannedMetadataSha1 != "" && plannedMetadataSha1 != metadataSha1 {
+		return fmt.Errorf("the metadata at %s changed since it was planned, run terraform plan again to apply the new metadata", metadataUrl)
+	}
+
+	data.Set("metadata_sha1", metadataSha1)
+
+	if metadata == "" {
+		return nil
+	}
+
+	description, err := keycloakClient.NewSamlClientDescriptionFromMetadata(ctx, client.RealmId, metadata)
+	if err != nil {
+		return err
+	}
+
+	metadataAttributes := []struct {
+		key       string
+		attribute string
+		value     *string
+	}{
+		{"signing_certificate", "saml.signing.certificate", &client.Attributes.SigningCertificate},
+		{"encryption_certificate", "saml.encryption.certificate", &client.Attributes.EncryptionCertificate},
+		{"assertion_consumer_post_url", "saml_assertion_consumer_url_post", &client.Attributes.AssertionConsumerPostURL},
+		{"assertion_consumer_redirect_url", "saml_assertion_consumer_url_redirect", &client.Attributes.AssertionConsumerRedirectURL},
+		{"logout_service_post_binding_url", "saml_single_logout_service_url_post", &client.Attributes.LogoutServicePostBindingURL},
+		{"logout_service_redirect_binding_url", "saml_single_logout_service_url_redirect", &client.Attributes.LogoutServiceRedirectBindingURL},
+	}
+
+	rawConfig := data.GetRawConfig()
+	for _, metadataAttribute := range metadataAttributes {
+		if !rawConfig.GetAttr(metadataAttribute.key).IsNull() {
+			continue
+		}
+
+		*metadataAttribute.value = description.Attributes[metadataAttribute.attribute]
+	}
+
+	return nil
+}
+
+// resourceKeycloakSamlClientCustomizeDiff compares the metadata of the client with the metadata it was last created or updated
+// from, so that a change of a metadata_url document is planned like a change of the configuration
+func resourceKeycloakSamlClientCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	metadataChanged := !diff.NewValueKnown("metadata_xml") || !diff.NewValueKnown("metadata_url")
+
+	if metadataChanged {
+		err := diff.SetNewComputed("metadata_sha1")
+		if err != nil {
+			return err
+		}
+	} else {
+		metadata, err := getSamlClientMetadata(ctx, keycloakClient, diff.Get("metadata_xml").(string), diff.Get("metadata_url").(string))
+		if err != nil {
+			return err
+		}
+
+		metadataSha1 := samlClientMetadataSha1(metadata)
+		if metadataSha1 == diff.Get("metadata_sha1").(string) {
+			return nil
+		}
+
+		err = diff.SetNew("metadata_sha1", metadataSha1)
+		if err != nil {
+			return err
+		}
+
+		if metadata == "" {
+			return nil
+		}
+	}
+
+	// certificates that are not given are taken from the new metadata
+	rawConfig := diff.GetRawConfig()
+	for _, key := range []string{"signing_certificate", "encryption_certificate"} {
+		if !rawConfig.GetAttr(key).IsNull() {
+			continue
+		}
+
+		for _, computedKey := range []string{key, key + "_sha1"} {
+			err := diff.SetNewComputed(computedKey)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
 func resourceKeycloakSamlClientCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
 	client := mapToSamlClientFromData(data)
 
-	err := keycloakClient.NewSamlClient(ctx, client)
+	setOwnershipTagAttribute(keycloakClient, client.Attributes.ExtraConfig)
+
+	err := applySamlClientMetadata(ctx, keycloakClient, data, client)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = keycloakClient.ValidateSamlClient(ctx, client)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = keycloakClient.NewSamlClient(ctx, client)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -436,6 +672,11 @@ func resourceKeycloakSamlClientRead(ctx context.Context, data *schema.ResourceDa
 		return handleNotFoundError(ctx, err, data)
 	}
 
+	err = keycloakClient.ValidateOwnership(fmt.Sprintf("saml client %s", client.ClientId), getOwnerFromAttributes(client.Attributes.ExtraConfig))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	err = mapToDataFromSamlClient(ctx, data, client)
 	if err != nil {
 		return diag.FromErr(err)
@@ -449,7 +690,19 @@ func resourceKeycloakSamlClientUpdate(ctx context.Context, data *schema.Resource
 
 	client := mapToSamlClientFromData(data)
 
-	err := keycloakClient.UpdateSamlClient(ctx, client)
+	setOwnershipTagAttribute(keycloakClient, client.Attributes.ExtraConfig)
+
+	err := applySamlClientMetadata(ctx, keycloakClient, data, client)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = keycloakClient.ValidateSamlClient(ctx, client)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = keycloakClient.UpdateSamlClient(ctx, client)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -468,6 +721,20 @@ func resourceKeycloakSamlClientDelete(ctx context.Context, data *schema.Resource
 	realmId := data.Get("realm_id").(string)
 	id := data.Id()
 
+	client, err := keycloakClient.GetSamlClient(ctx, realmId, id)
+	if err != nil {
+		if keycloak.ErrorIs404(err) {
+			return nil
+		}
+
+		return diag.FromErr(err)
+	}
+
+	err = keycloakClient.ValidateOwnership(fmt.Sprintf("saml client %s", client.ClientId), getOwnerFromAttributes(client.Attributes.ExtraConfig))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	return diag.FromErr(keycloakClient.DeleteSamlClient(ctx, realmId, id))
 }
 
@@ -0,0 +1,203 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+)
+
+var (
+	keycloakSamlClientKeyTypes = []string{keycloak.SamlClientKeyTypeSigning, keycloak.SamlClientKeyTypeEncryption}
+)
+
+func resourceKeycloakSamlClientKey() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceKeycloakSamlClientKeyCreate,
+		ReadContext:   resourceKeycloakSamlClientKeyRead,
+		UpdateContext: resourceKeycloakSamlClientKeyUpdate,
+		DeleteContext: resourceKeycloakSamlClientKeyDelete,
+		// This resource can be imported using {{realm}}/{{clientId}}/{{keyType}}. The Client ID is the unique ID Keycloak assigns to the client upon creation.
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceKeycloakSamlClientKeyImport,
+		},
+		CustomizeDiff: resourceKeycloakSamlClientKeyCustomizeDiff,
+		Schema: map[string]*schema.Schema{
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"client_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"key_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(keycloakSamlClientKeyTypes, false),
+			},
+			"rotation_triggers": {
+				Type:     schema.TypeMap,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Optional: true,
+			},
+			"rotate_before_expiry": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				DiffSuppressFunc: suppressDurationStringDiff,
+				ValidateFunc: func(i interface{}, k string) ([]string, []error) {
+					if _, err := time.ParseDuration(i.(string)); err != nil {
+						return nil, []error{fmt.Errorf("expected %s to be a duration, got %s", k, i.(string))}
+					}
+
+					return nil, nil
+				},
+			},
+			"certificate": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"private_key": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"certificate_not_before": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"certificate_not_after": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// samlClientKeyExpiring reports whether the certificate expires within the window configured on this resource
+func samlClientKeyExpiring(rotateBeforeExpiry, notAfter string) bool {
+	if rotateBeforeExpiry == "" || notAfter == "" {
+		return false
+	}
+
+	window, err := time.ParseDuration(rotateBeforeExpiry)
+	if err != nil {
+		return false
+	}
+
+	expiry, err := time.Parse(time.RFC3339, notAfter)
+	if err != nil {
+		return false
+	}
+
+	return !time.Now().Add(window).Before(expiry)
+}
+
+func resourceKeycloakSamlClientKeyCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+
+	if d.HasChange("rotation_triggers") || samlClientKeyExpiring(d.Get("rotate_before_expiry").(string), d.Get("certificate_not_after").(string)) {
+		for _, key := range []string{"certificate", "private_key", "certificate_not_before", "certificate_not_after"} {
+			if err := d.SetNewComputed(key); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func setSamlClientKeyData(data *schema.ResourceData, key *keycloak.SamlClientKey) {
+	data.SetId(key.RealmId + "/" + key.ClientId + "/" + key.KeyType)
+
+	data.Set("realm_id", key.RealmId)
+	data.Set("client_id", key.ClientId)
+	data.Set("key_type", key.KeyType)
+	data.Set("certificate", key.Certificate)
+	data.Set("certificate_not_before", key.NotBefore.UTC().Format(time.RFC3339))
+	data.Set("certificate_not_after", key.NotAfter.UTC().Format(time.RFC3339))
+
+	// older versions of keycloak do not return the private key once it has been generated
+	if key.PrivateKey != "" {
+		data.Set("private_key", key.PrivateKey)
+	}
+}
+
+func resourceKeycloakSamlClientKeyCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	key, err := keycloakClient.GenerateSamlClientKey(ctx, data.Get("realm_id").(string), data.Get("client_id").(string), data.Get("key_type").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	setSamlClientKeyData(data, key)
+
+	return nil
+}
+
+func resourceKeycloakSamlClientKeyRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	key, err := keycloakClient.GetSamlClientKey(ctx, data.Get("realm_id").(string), data.Get("client_id").(string), data.Get("key_type").(string))
+	if err != nil {
+		return handleNotFoundError(ctx, err, data)
+	}
+
+	// the key was removed from the client, so a new one is generated on the next apply
+	if key.Certificate == "" {
+		data.SetId("")
+		return nil
+	}
+
+	setSamlClientKeyData(data, key)
+
+	return nil
+}
+
+func resourceKeycloakSamlClientKeyUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	// the planned expiry is unknown once a rotation is planned, so the window is checked against the certificate in the state
+	notAfter, _ := data.GetChange("certificate_not_after")
+	if !data.HasChange("rotation_triggers") && !samlClientKeyExpiring(data.Get("rotate_before_expiry").(string), notAfter.(string)) {
+		return resourceKeycloakSamlClientKeyRead(ctx, data, meta)
+	}
+
+	key, err := keycloakClient.GenerateSamlClientKey(ctx, data.Get("realm_id").(string), data.Get("client_id").(string), data.Get("key_type").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	setSamlClientKeyData(data, key)
+
+	return nil
+}
+
+// the key belongs to the client, so it is left as it is when this resource is destroyed
+func resourceKeycloakSamlClientKeyDelete(_ context.Context, _ *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	return nil
+}
+
+func resourceKeycloakSamlClientKeyImport(ctx context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("Invalid import. Supported import format: {{realm}}/{{clientId}}/{{keyType}}.")
+	}
+
+	d.Set("realm_id", parts[0])
+	d.Set("client_id", parts[1])
+	d.Set("key_type", parts[2])
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,136 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccKeycloakSamlClientKey_basic(t *testing.T) {
+	t.Parallel()
+
+	clientId := acctest.RandomWithPrefix("tf-acc")
+	resourceName := "keycloak_saml_client_key.signing"
+
+	var certificate string
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakSamlClientDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakSamlClientKey_basic(clientId, "1", ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "private_key"),
+					resource.TestCheckResourceAttrSet(resourceName, "certificate_not_before"),
+					resource.TestCheckResourceAttrSet(resourceName, "certificate_not_after"),
+					testAccCheckKeycloakSamlClientKeyCertificate(resourceName, &certificate, false),
+					resource.TestCheckResourceAttrSet("keycloak_saml_client_key.encryption", "certificate"),
+				),
+			},
+			{
+				Config: testKeycloakSamlClientKey_basic(clientId, "2", ""),
+				Check:  testAccCheckKeycloakSamlClientKeyCertificate(resourceName, &certificate, true),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"rotation_triggers"},
+			},
+		},
+	})
+}
+
+func TestAccKeycloakSamlClientKey_rotateBeforeExpiry(t *testing.T) {
+	t.Parallel()
+
+	clientId := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakSamlClientDestroy(),
+		Steps: []resource.TestStep{
+			{
+				// a generated certificate is valid for much less than a hundred years, so every plan rotates the key
+				Config:             testKeycloakSamlClientKey_basic(clientId, "1", "876000h"),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testKeycloakSamlClientKey_basic(clientId, "1", "24h"),
+			},
+		},
+	})
+}
+
+// testAccCheckKeycloakSamlClientKeyCertificate checks that the certificate in the state is the one the client uses, stores it, and
+// checks whether it differs from the one stored before
+func testAccCheckKeycloakSamlClientKeyCertificate(resourceName string, certificate *string, expectRotated bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		client, err := keycloakClient.GetSamlClient(testCtx, rs.Primary.Attributes["realm_id"], rs.Primary.Attributes["client_id"])
+		if err != nil {
+			return err
+		}
+
+		stateCertificate := rs.Primary.Attributes["certificate"]
+		if stateCertificate == "" || stateCertificate != client.Attributes.SigningCertificate {
+			return fmt.Errorf("expected the certificate of %s to be the signing certificate of the client", resourceName)
+		}
+
+		if expectRotated && stateCertificate == *certificate {
+			return fmt.Errorf("expected the certificate of %s to have been rotated", resourceName)
+		}
+
+		*certificate = stateCertificate
+
+		return nil
+	}
+}
+
+func testKeycloakSamlClientKey_basic(clientId, rotation, rotateBeforeExpiry string) string {
+	rotateBeforeExpiryAttribute := ""
+	if rotateBeforeExpiry != "" {
+		rotateBeforeExpiryAttribute = fmt.Sprintf(`rotate_before_expiry = "%s"`, rotateBeforeExpiry)
+	}
+
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_saml_client" "saml_client" {
+	realm_id           = data.keycloak_realm.realm.id
+	client_id          = "%s"
+	sign_documents     = true
+	encrypt_assertions = true
+}
+
+resource "keycloak_saml_client_key" "signing" {
+	realm_id  = data.keycloak_realm.realm.id
+	client_id = keycloak_saml_client.saml_client.id
+	key_type  = "signing"
+
+	rotation_triggers = {
+		rotation = "%s"
+	}
+
+	%s
+}
+
+resource "keycloak_saml_client_key" "encryption" {
+	realm_id  = data.keycloak_realm.realm.id
+	client_id = keycloak_saml_client.saml_client.id
+	key_type  = "encryption"
+}
+	`, testAccRealm.Realm, clientId, rotation, rotateBeforeExpiryAttribute)
+}
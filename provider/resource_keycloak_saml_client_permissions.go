@@ -0,0 +1,57 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+)
+
+func resourceKeycloakSamlClientPermissions() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceKeycloakSamlClientPermissionsReconcile,
+		ReadContext:   resourceKeycloakOpenidClientPermissionsRead,
+		DeleteContext: resourceKeycloakOpenidClientPermissionsDelete,
+		UpdateContext: resourceKeycloakSamlClientPermissionsReconcile,
+		// This resource can be imported using {{realm}}/{{client_id}}. The Client ID is displayed in the GUI
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceKeycloakSamlClientPermissionsImport,
+		},
+		Schema: clientPermissionsSchema(),
+	}
+}
+
+func resourceKeycloakSamlClientPermissionsReconcile(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	clientId := data.Get("client_id").(string)
+
+	client, err := keycloakClient.GetGenericClient(ctx, realmId, clientId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if client.Protocol != "saml" {
+		return diag.Errorf("validation error: client %s is not a saml client", clientId)
+	}
+
+	// management permissions are protocol agnostic in keycloak, so the openid client implementation can be reused
+	return resourceKeycloakOpenidClientPermissionsReconcile(ctx, data, meta)
+}
+
+func resourceKeycloakSamlClientPermissionsImport(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("Invalid import. Supported import formats: {{realmId}}/{{samlClientId}}")
+	}
+	d.Set("realm_id", parts[0])
+	d.Set("client_id", parts[1])
+
+	d.SetId(clientPermissionsId(parts[0], parts[1]))
+
+	return []*schema.ResourceData{d}, nil
+}
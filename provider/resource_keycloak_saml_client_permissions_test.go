@@ -0,0 +1,153 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccKeycloakSamlClientPermission_basic(t *testing.T) {
+	t.Parallel()
+	clientId := acctest.RandomWithPrefix("tf-acc")
+	username := acctest.RandomWithPrefix("tf-acc")
+	email := acctest.RandomWithPrefix("tf-acc") + "@fakedomain.com"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakSamlClientPermission_basic(clientId, username, email, true),
+				Check:  testAccCheckKeycloakOpenidClientPermissionExists("keycloak_saml_client_permissions.my_permission"),
+			},
+			{
+				Config: testKeycloakSamlClientPermission_basic(clientId, username, email, false),
+				Check:  testAccCheckKeycloakSamlClientPermissionsAreDisabled(clientId),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakSamlClientPermission_openidClient(t *testing.T) {
+	t.Parallel()
+	clientId := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config:      testKeycloakSamlClientPermission_openidClient(clientId),
+				ExpectError: regexp.MustCompile("is not a saml client"),
+			},
+		},
+	})
+}
+
+func testAccCheckKeycloakSamlClientPermissionsAreDisabled(clientId string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client, err := keycloakClient.GetGenericClientByClientId(testCtx, testAccRealm.Realm, clientId)
+		if err != nil {
+			return err
+		}
+
+		permissions, err := keycloakClient.GetOpenidClientPermissions(testCtx, testAccRealm.Realm, client.Id)
+		if err != nil {
+			return fmt.Errorf("error getting saml_client permissions with realm id %s and client id %s: %s", testAccRealm.Realm, clientId, err)
+		}
+
+		if permissions.Enabled != false {
+			return fmt.Errorf("expected saml client permission in Keycloak to be disabled")
+		}
+
+		return nil
+	}
+}
+
+func testKeycloakSamlClientPermission_basic(clientId, username, email string, enabled bool) string {
+	permission := ""
+	if enabled {
+		permission = `
+resource "keycloak_saml_client_permissions" "my_permission" {
+	realm_id  = data.keycloak_realm.realm.id
+	client_id = keycloak_saml_client.saml_client.id
+
+	view_scope {
+		policies          = [
+			keycloak_openid_client_user_policy.test.id
+		]
+		description       = "view_scope"
+		decision_strategy = "CONSENSUS"
+	}
+}`
+	}
+
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_saml_client" "saml_client" {
+	realm_id  = data.keycloak_realm.realm.id
+	client_id = "%s"
+}
+
+data "keycloak_openid_client" "realm_management" {
+	realm_id  = data.keycloak_realm.realm.id
+	client_id = "realm-management"
+}
+
+resource keycloak_openid_client_permissions "realm-management_permission" {
+	realm_id  = data.keycloak_realm.realm.id
+	client_id = data.keycloak_openid_client.realm_management.id
+}
+
+resource keycloak_user test {
+	realm_id = data.keycloak_realm.realm.id
+	username = "%s"
+
+	email      = "%s"
+	first_name = "Testy"
+	last_name  = "Tester"
+}
+
+resource keycloak_openid_client_user_policy test {
+	realm_id           = data.keycloak_realm.realm.id
+	resource_server_id = data.keycloak_openid_client.realm_management.id
+
+	name  = "saml_client_user_policy_test"
+	users = [
+		keycloak_user.test.id
+	]
+
+	logic             = "POSITIVE"
+	decision_strategy = "UNANIMOUS"
+
+	depends_on = [
+		keycloak_openid_client_permissions.realm-management_permission,
+	]
+}
+%s`, testAccRealm.Realm, clientId, username, email, permission)
+}
+
+func testKeycloakSamlClientPermission_openidClient(clientId string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_openid_client" "openid_client" {
+	realm_id    = data.keycloak_realm.realm.id
+	client_id   = "%s"
+	access_type = "PUBLIC"
+}
+
+resource "keycloak_saml_client_permissions" "my_permission" {
+	realm_id  = data.keycloak_realm.realm.id
+	client_id = keycloak_openid_client.openid_client.id
+}`, testAccRealm.Realm, clientId)
+}
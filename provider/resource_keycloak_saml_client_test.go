@@ -350,6 +350,151 @@ func TestAccKeycloakSamlClient_extraConfigInvalid(t *testing.T) {
 	})
 }
 
+func TestAccKeycloakSamlClient_metadataXml(t *testing.T) {
+	t.Parallel()
+
+	clientId := acctest.RandomWithPrefix("tf-acc")
+	_, certificate := generateKeyAndCert(2048)
+	_, updatedCertificate := generateKeyAndCert(2048)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakSamlClientDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakSamlClient_metadataXml(clientId, "https://sp.example.com/acs", certificate, ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakSamlClientExistsWithCorrectProtocol("keycloak_saml_client.saml_client"),
+					resource.TestCheckResourceAttr("keycloak_saml_client.saml_client", "signing_certificate", certificate),
+					resource.TestCheckResourceAttr("keycloak_saml_client.saml_client", "assertion_consumer_post_url", "https://sp.example.com/acs"),
+					resource.TestCheckResourceAttr("keycloak_saml_client.saml_client", "logout_service_redirect_binding_url", "https://sp.example.com/slo"),
+					resource.TestCheckResourceAttrSet("keycloak_saml_client.saml_client", "metadata_sha1"),
+				),
+			},
+			{
+				Config: testKeycloakSamlClient_metadataXml(clientId, "https://sp.example.com/acs/v2", updatedCertificate, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("keycloak_saml_client.saml_client", "signing_certificate", updatedCertificate),
+					resource.TestCheckResourceAttr("keycloak_saml_client.saml_client", "assertion_consumer_post_url", "https://sp.example.com/acs/v2"),
+				),
+			},
+			{
+				Config: testKeycloakSamlClient_metadataXml(clientId, "https://sp.example.com/acs/v2", updatedCertificate, "https://sp.example.com/override"),
+				Check:  resource.TestCheckResourceAttr("keycloak_saml_client.saml_client", "assertion_consumer_post_url", "https://sp.example.com/override"),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakSamlClient_metadataXmlInvalid(t *testing.T) {
+	t.Parallel()
+
+	clientId := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakSamlClientDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config:      testKeycloakSamlClient_metadataJson(clientId),
+				ExpectError: regexp.MustCompile("the metadata does not describe a saml service provider"),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakSamlClient_artifactBindingAndLogout(t *testing.T) {
+	skipIfVersionIsLessThanOrEqualTo(testCtx, t, keycloakClient, keycloak.Version_19)
+	t.Parallel()
+
+	clientId := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakSamlClientDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakSamlClient_artifactBindingAndLogout(clientId),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakSamlClientAttributes("keycloak_saml_client.saml_client", func(attributes *keycloak.SamlClientAttributes) error {
+						if !attributes.ArtifactBinding || !attributes.AllowEcpFlow {
+							return fmt.Errorf("expected saml client to use the artifact binding and to allow the ECP flow")
+						}
+						if attributes.ArtifactResolutionServiceURL != "https://sp.example.com/saml/ars" {
+							return fmt.Errorf("expected saml client to have artifact resolution service url https://sp.example.com/saml/ars, got %s", attributes.ArtifactResolutionServiceURL)
+						}
+						if attributes.LogoutServiceSoapBindingURL != "https://sp.example.com/saml/slo/soap" {
+							return fmt.Errorf("expected saml client to have soap logout url https://sp.example.com/saml/slo/soap, got %s", attributes.LogoutServiceSoapBindingURL)
+						}
+						if attributes.ExtraConfig["contract.id"] != "gov-1234" {
+							return fmt.Errorf("expected saml client to keep the attributes in extra_config")
+						}
+
+						return nil
+					}),
+					resource.TestCheckResourceAttr("keycloak_saml_client.saml_client", "artifact_binding_url", "https://sp.example.com/saml/artifact"),
+					resource.TestCheckResourceAttr("keycloak_saml_client.saml_client", "logout_service_artifact_binding_url", "https://sp.example.com/saml/slo/artifact"),
+				),
+			},
+			{
+				Config: testKeycloakSamlClient_basic(clientId),
+				Check: testAccCheckKeycloakSamlClientAttributes("keycloak_saml_client.saml_client", func(attributes *keycloak.SamlClientAttributes) error {
+					if attributes.ArtifactBinding || attributes.LogoutServiceSoapBindingURL != "" {
+						return fmt.Errorf("expected saml client to no longer use the artifact binding or soap logout")
+					}
+
+					return nil
+				}),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakSamlClient_encryptionAlgorithms(t *testing.T) {
+	skipIfVersionIsLessThanOrEqualTo(testCtx, t, keycloakClient, keycloak.Version_20)
+	t.Parallel()
+
+	clientId := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakSamlClientDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakSamlClient_encryptionAlgorithms(clientId, "http://www.w3.org/2009/xmlenc11#rsa-oaep", `"http://www.w3.org/2001/04/xmlenc#sha256"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("keycloak_saml_client.saml_client", "encryption_algorithm", "http://www.w3.org/2009/xmlenc11#aes256-gcm"),
+					resource.TestCheckResourceAttr("keycloak_saml_client.saml_client", "encryption_key_algorithm", "http://www.w3.org/2009/xmlenc11#rsa-oaep"),
+					resource.TestCheckResourceAttr("keycloak_saml_client.saml_client", "encryption_digest_method", "http://www.w3.org/2001/04/xmlenc#sha256"),
+				),
+			},
+			{
+				Config:      testKeycloakSamlClient_encryptionAlgorithms(clientId, "http://www.w3.org/2001/04/xmlenc#rsa-1_5", `"http://www.w3.org/2001/04/xmlenc#sha256"`),
+				ExpectError: regexp.MustCompile("a digest method cannot be used with the RSA 1.5 key algorithm"),
+			},
+			{
+				Config: testKeycloakSamlClient_encryptionAlgorithms(clientId, "http://www.w3.org/2001/04/xmlenc#rsa-1_5", "null"),
+				Check:  resource.TestCheckResourceAttr("keycloak_saml_client.saml_client", "encryption_digest_method", ""),
+			},
+		},
+	})
+}
+
+func testAccCheckKeycloakSamlClientAttributes(resourceName string, check func(attributes *keycloak.SamlClientAttributes) error) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client, err := getSamlClientFromState(s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		return check(client.Attributes)
+	}
+}
+
 func testAccCheckKeycloakSamlClientExistsWithCorrectProtocol(resourceName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		client, err := getSamlClientFromState(s, resourceName)
@@ -843,3 +988,100 @@ resource "keycloak_saml_client" "saml_client" {
 }
 	`, testAccRealm.Realm, clientId, sb.String())
 }
+
+func testKeycloakSamlClient_metadataXml(clientId, assertionConsumerUrl, certificate, assertionConsumerUrlOverride string) string {
+	override := ""
+	if assertionConsumerUrlOverride != "" {
+		override = fmt.Sprintf(`assertion_consumer_post_url = "%s"`, assertionConsumerUrlOverride)
+	}
+
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_saml_client" "saml_client" {
+	client_id = "%s"
+	realm_id  = data.keycloak_realm.realm.id
+
+	metadata_xml = <<EOF
+<md:EntityDescriptor xmlns:md="urn:oasis:names:tc:SAML:2.0:metadata" entityID="%s">
+	<md:SPSSODescriptor AuthnRequestsSigned="true" protocolSupportEnumeration="urn:oasis:names:tc:SAML:2.0:protocol">
+		<md:KeyDescriptor use="signing">
+			<ds:KeyInfo xmlns:ds="http://www.w3.org/2000/09/xmldsig#">
+				<ds:X509Data>
+					<ds:X509Certificate>%s</ds:X509Certificate>
+				</ds:X509Data>
+			</ds:KeyInfo>
+		</md:KeyDescriptor>
+		<md:SingleLogoutService Binding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-Redirect" Location="https://sp.example.com/slo"/>
+		<md:AssertionConsumerService Binding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST" Location="%s" index="1"/>
+	</md:SPSSODescriptor>
+</md:EntityDescriptor>
+	EOF
+
+	%s
+}
+	`, testAccRealm.Realm, clientId, clientId, certificate, assertionConsumerUrl, override)
+}
+
+func testKeycloakSamlClient_metadataJson(clientId string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_saml_client" "saml_client" {
+	client_id = "%s"
+	realm_id  = data.keycloak_realm.realm.id
+
+	metadata_xml = jsonencode({
+		clientId = "%s"
+	})
+}
+	`, testAccRealm.Realm, clientId, clientId)
+}
+
+func testKeycloakSamlClient_artifactBindingAndLogout(clientId string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_saml_client" "saml_client" {
+	client_id = "%s"
+	realm_id  = data.keycloak_realm.realm.id
+
+	artifact_binding                = true
+	artifact_binding_url            = "https://sp.example.com/saml/artifact"
+	artifact_resolution_service_url = "https://sp.example.com/saml/ars"
+	allow_ecp_flow                  = true
+
+	logout_service_artifact_binding_url = "https://sp.example.com/saml/slo/artifact"
+	logout_service_soap_binding_url     = "https://sp.example.com/saml/slo/soap"
+
+	extra_config = {
+		"contract.id" = "gov-1234"
+	}
+}
+	`, testAccRealm.Realm, clientId)
+}
+
+func testKeycloakSamlClient_encryptionAlgorithms(clientId, keyAlgorithm, digestMethod string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_saml_client" "saml_client" {
+	client_id          = "%s"
+	realm_id           = data.keycloak_realm.realm.id
+	encrypt_assertions = true
+
+	encryption_certificate   = file("misc/saml-cert.pem")
+	encryption_algorithm     = "http://www.w3.org/2009/xmlenc11#aes256-gcm"
+	encryption_key_algorithm = "%s"
+	encryption_digest_method = %s
+}
+	`, testAccRealm.Realm, clientId, keyAlgorithm, digestMethod)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -19,6 +20,7 @@ func resourceKeycloakUser() *schema.Resource {
 		ReadContext:   resourceKeycloakUserRead,
 		DeleteContext: resourceKeycloakUserDelete,
 		UpdateContext: resourceKeycloakUserUpdate,
+		CustomizeDiff: resourceKeycloakUserCustomizeDiff,
 		// This resource can be imported using {{realm}}/{{user_id}}. The User's ID is displayed in the GUI when editing
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceKeycloakUserImport,
@@ -61,8 +63,27 @@ func resourceKeycloakUser() *schema.Resource {
 				Optional: true,
 			},
 			"attributes": {
-				Type:     schema.TypeMap,
-				Optional: true,
+				Type:          schema.TypeMap,
+				Optional:      true,
+				ConflictsWith: []string{"attribute"},
+			},
+			"attribute": {
+				Type:          schema.TypeSet,
+				Optional:      true,
+				ConflictsWith: []string{"attributes"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"values": {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
 			},
 			"required_actions": {
 				Type:     schema.TypeSet,
@@ -122,8 +143,55 @@ func onlyDiffOnCreate(_, _, _ string, d *schema.ResourceData) bool {
 	return d.Id() != ""
 }
 
-func mapFromDataToUser(data *schema.ResourceData) *keycloak.User {
+type userAttributesGetter interface {
+	GetOk(string) (interface{}, bool)
+}
+
+// getUserAttributesFromData reads either the attributes map or the attribute blocks, which are mutually exclusive
+func getUserAttributesFromData(data userAttributesGetter) map[string][]string {
 	attributes := map[string][]string{}
+
+	if v, ok := data.GetOk("attributes"); ok {
+		for key, value := range v.(map[string]interface{}) {
+			attributes[key] = strings.Split(value.(string), MULTIVALUE_ATTRIBUTE_SEPARATOR)
+		}
+	}
+	if v, ok := data.GetOk("attribute"); ok {
+		for _, a := range v.(*schema.Set).List() {
+			attribute := a.(map[string]interface{})
+
+			values := []string{}
+			for _, value := range attribute["values"].([]interface{}) {
+				values = append(values, value.(string))
+			}
+
+			attributes[attribute["name"].(string)] = values
+		}
+	}
+
+	return attributes
+}
+
+func getUserAttributeBlocks(attributes map[string][]string) []interface{} {
+	var names []string
+	for name := range attributes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var attributeBlocks []interface{}
+	for _, name := range names {
+		attributeBlocks = append(attributeBlocks, map[string]interface{}{
+			"name":   name,
+			"values": attributes[name],
+		})
+	}
+
+	return attributeBlocks
+}
+
+func mapFromDataToUser(data *schema.ResourceData) *keycloak.User {
+	attributes := getUserAttributesFromData(data)
 	var requiredActions []string
 
 	if v, ok := data.GetOk("required_actions"); ok {
@@ -131,16 +199,12 @@ func mapFromDataToUser(data *schema.ResourceData) *keycloak.User {
 			requiredActions = append(requiredActions, requiredAction.(string))
 		}
 	}
-	if v, ok := data.GetOk("attributes"); ok {
-		for key, value := range v.(map[string]interface{}) {
-			attributes[key] = strings.Split(value.(string), MULTIVALUE_ATTRIBUTE_SEPARATOR)
-		}
-	}
 
-	federatedIdentities := &keycloak.FederatedIdentities{}
+	// links are left alone when none are configured, so that they can be managed by keycloak_user_federated_identity
+	var federatedIdentities keycloak.FederatedIdentities
 
 	if v, ok := data.GetOk("federated_identity"); ok {
-		federatedIdentities = getUserFederatedIdentitiesFromData(v.(*schema.Set).List())
+		federatedIdentities = *getUserFederatedIdentitiesFromData(v.(*schema.Set).List())
 	}
 
 	return &keycloak.User{
@@ -153,7 +217,7 @@ func mapFromDataToUser(data *schema.ResourceData) *keycloak.User {
 		LastName:            data.Get("last_name").(string),
 		Enabled:             data.Get("enabled").(bool),
 		Attributes:          attributes,
-		FederatedIdentities: *federatedIdentities,
+		FederatedIdentities: federatedIdentities,
 		RequiredActions:     requiredActions,
 	}
 }
@@ -194,11 +258,30 @@ func mapFromUserToData(data *schema.ResourceData, user *keycloak.User) {
 	data.Set("first_name", user.FirstName)
 	data.Set("last_name", user.LastName)
 	data.Set("enabled", user.Enabled)
-	data.Set("attributes", attributes)
+	// attributes are read back in whichever form the configuration uses, the map is used when importing
+	if _, ok := data.GetOk("attribute"); ok {
+		data.Set("attribute", getUserAttributeBlocks(user.Attributes))
+	} else {
+		data.Set("attributes", attributes)
+	}
 	data.Set("federated_identity", federatedIdentities)
 	data.Set("required_actions", user.RequiredActions)
 }
 
+// Attributes are checked against the user profile of the realm during plan, so that a user the profile would reject fails before anything is applied
+func resourceKeycloakUserCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("realm_id") || !diff.NewValueKnown("attributes") || !diff.NewValueKnown("attribute") {
+		return nil
+	}
+	if diff.Id() != "" && !diff.HasChange("attributes") && !diff.HasChange("attribute") {
+		return nil
+	}
+
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	return keycloakClient.ValidateUserAttributes(ctx, diff.Get("realm_id").(string), getUserAttributesFromData(diff))
+}
+
 func resourceKeycloakUserCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
@@ -236,6 +319,11 @@ func resourceKeycloakUserRead(ctx context.Context, data *schema.ResourceData, me
 		return handleNotFoundError(ctx, err, data)
 	}
 
+	// links are only tracked when they are configured, otherwise they may belong to keycloak_user_federated_identity
+	if _, ok := data.GetOk("federated_identity"); !ok {
+		user.FederatedIdentities = nil
+	}
+
 	mapFromUserToData(data, user)
 
 	return nil
@@ -246,6 +334,11 @@ func resourceKeycloakUserUpdate(ctx context.Context, data *schema.ResourceData,
 
 	user := mapFromDataToUser(data)
 
+	// the last link was removed from the configuration, so every link has to be removed
+	if user.FederatedIdentities == nil && data.HasChange("federated_identity") {
+		user.FederatedIdentities = keycloak.FederatedIdentities{}
+	}
+
 	err := keycloakClient.UpdateUser(ctx, user)
 	if err != nil {
 		return diag.FromErr(err)
@@ -0,0 +1,127 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+)
+
+func resourceKeycloakUserFederatedIdentity() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceKeycloakUserFederatedIdentityCreate,
+		ReadContext:   resourceKeycloakUserFederatedIdentityRead,
+		DeleteContext: resourceKeycloakUserFederatedIdentityDelete,
+		// This resource can be imported using {{realm}}/{{user_id}}/{{identity_provider}}
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceKeycloakUserFederatedIdentityImport,
+		},
+		// Keycloak cannot update a link, so every change replaces it
+		Schema: map[string]*schema.Schema{
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"user_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"identity_provider": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"federated_user_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"federated_username": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func userFederatedIdentityId(realmId, userId, identityProvider string) string {
+	return fmt.Sprintf("%s/%s/%s", realmId, userId, identityProvider)
+}
+
+func resourceKeycloakUserFederatedIdentityCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	userId := data.Get("user_id").(string)
+
+	federatedIdentity := &keycloak.FederatedIdentity{
+		IdentityProvider: data.Get("identity_provider").(string),
+		UserId:           data.Get("federated_user_id").(string),
+		UserName:         data.Get("federated_username").(string),
+	}
+
+	err := keycloakClient.NewUserFederatedIdentity(ctx, realmId, userId, federatedIdentity)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data.SetId(userFederatedIdentityId(realmId, userId, federatedIdentity.IdentityProvider))
+
+	return resourceKeycloakUserFederatedIdentityRead(ctx, data, meta)
+}
+
+func resourceKeycloakUserFederatedIdentityRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	userId := data.Get("user_id").(string)
+
+	federatedIdentity, err := keycloakClient.GetUserFederatedIdentity(ctx, realmId, userId, data.Get("identity_provider").(string))
+	if err != nil {
+		return handleNotFoundError(ctx, err, data)
+	}
+	if federatedIdentity == nil {
+		data.SetId("")
+		return nil
+	}
+
+	data.Set("identity_provider", federatedIdentity.IdentityProvider)
+	data.Set("federated_user_id", federatedIdentity.UserId)
+	data.Set("federated_username", federatedIdentity.UserName)
+
+	return nil
+}
+
+func resourceKeycloakUserFederatedIdentityDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	userId := data.Get("user_id").(string)
+	identityProvider := data.Get("identity_provider").(string)
+
+	err := keycloakClient.DeleteUserFederatedIdentity(ctx, realmId, userId, identityProvider)
+	if err != nil && !keycloak.ErrorIs404(err) {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceKeycloakUserFederatedIdentityImport(ctx context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("Invalid import. Supported import formats: {{realmId}}/{{userId}}/{{identityProvider}}")
+	}
+
+	d.Set("realm_id", parts[0])
+	d.Set("user_id", parts[1])
+	d.Set("identity_provider", parts[2])
+
+	return []*schema.ResourceData{d}, nil
+}
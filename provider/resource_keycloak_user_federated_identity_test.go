@@ -0,0 +1,153 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccKeycloakUserFederatedIdentity_basic(t *testing.T) {
+	t.Parallel()
+	alias := acctest.RandomWithPrefix("tf-acc")
+	username := acctest.RandomWithPrefix("tf-acc")
+	federatedUserId := acctest.RandString(10)
+
+	resourceName := "keycloak_user_federated_identity.link"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakUserFederatedIdentityDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakUserFederatedIdentity_basic(alias, username, federatedUserId, "first"),
+				Check:  testAccCheckKeycloakUserFederatedIdentityExists(resourceName, "first"),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testKeycloakUserFederatedIdentity_basic(alias, username, federatedUserId, "second"),
+				Check:  testAccCheckKeycloakUserFederatedIdentityExists(resourceName, "second"),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakUserFederatedIdentity_createAfterManualDestroy(t *testing.T) {
+	t.Parallel()
+	alias := acctest.RandomWithPrefix("tf-acc")
+	username := acctest.RandomWithPrefix("tf-acc")
+	federatedUserId := acctest.RandString(10)
+
+	resourceName := "keycloak_user_federated_identity.link"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakUserFederatedIdentityDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakUserFederatedIdentity_basic(alias, username, federatedUserId, "first"),
+				Check:  testAccCheckKeycloakUserFederatedIdentityExists(resourceName, "first"),
+			},
+			{
+				PreConfig: func() {
+					user, err := keycloakClient.GetUserByUsername(testCtx, testAccRealm.Realm, username)
+					if err != nil {
+						t.Fatal(err)
+					}
+
+					err = keycloakClient.DeleteUserFederatedIdentity(testCtx, testAccRealm.Realm, user.Id, alias)
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testKeycloakUserFederatedIdentity_basic(alias, username, federatedUserId, "first"),
+				Check:  testAccCheckKeycloakUserFederatedIdentityExists(resourceName, "first"),
+			},
+		},
+	})
+}
+
+func testAccCheckKeycloakUserFederatedIdentityExists(resourceName, federatedUsername string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		realmId := rs.Primary.Attributes["realm_id"]
+		userId := rs.Primary.Attributes["user_id"]
+		identityProvider := rs.Primary.Attributes["identity_provider"]
+
+		federatedIdentity, err := keycloakClient.GetUserFederatedIdentity(testCtx, realmId, userId, identityProvider)
+		if err != nil {
+			return err
+		}
+		if federatedIdentity == nil {
+			return fmt.Errorf("expected user %s to be linked to identity provider %s", userId, identityProvider)
+		}
+		if federatedIdentity.UserName != federatedUsername {
+			return fmt.Errorf("expected federated username %s, got %s", federatedUsername, federatedIdentity.UserName)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckKeycloakUserFederatedIdentityDestroy() resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "keycloak_user_federated_identity" {
+				continue
+			}
+
+			realmId := rs.Primary.Attributes["realm_id"]
+			userId := rs.Primary.Attributes["user_id"]
+			identityProvider := rs.Primary.Attributes["identity_provider"]
+
+			federatedIdentity, _ := keycloakClient.GetUserFederatedIdentity(testCtx, realmId, userId, identityProvider)
+			if federatedIdentity != nil {
+				return fmt.Errorf("user %s is still linked to identity provider %s", userId, identityProvider)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testKeycloakUserFederatedIdentity_basic(alias, username, federatedUserId, federatedUsername string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_oidc_identity_provider" "idp" {
+	realm             = data.keycloak_realm.realm.id
+	alias             = "%s"
+	authorization_url = "https://example.com/auth"
+	token_url         = "https://example.com/token"
+	client_id         = "example_id"
+	client_secret     = "example_token"
+}
+
+resource "keycloak_user" "user" {
+	realm_id = data.keycloak_realm.realm.id
+	username = "%s"
+}
+
+resource "keycloak_user_federated_identity" "link" {
+	realm_id           = data.keycloak_realm.realm.id
+	user_id            = keycloak_user.user.id
+	identity_provider  = keycloak_oidc_identity_provider.idp.alias
+	federated_user_id  = "%s"
+	federated_username = "%s"
+}
+	`, testAccRealm.Realm, alias, username, federatedUserId, federatedUsername)
+}
@@ -0,0 +1,184 @@
+package provider
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+)
+
+func resourceKeycloakUserPassword() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceKeycloakUserPasswordCreate,
+		ReadContext:   resourceKeycloakUserPasswordRead,
+		DeleteContext: resourceKeycloakUserPasswordDelete,
+		UpdateContext: resourceKeycloakUserPasswordUpdate,
+		Schema: map[string]*schema.Schema{
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"user_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"password": {
+				Type:             schema.TypeString,
+				Required:         true,
+				Sensitive:        true,
+				DiffSuppressFunc: suppressUserPasswordDiff,
+				Description:      "The password of the user. Only a salted HMAC-SHA256 hash of the password is stored in the state.",
+			},
+			"password_salt": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The random salt the hash of the password in the state is keyed with.",
+			},
+			"temporary": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"rotation_trigger": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Arbitrary values that cause the password to be set again when they change.",
+			},
+			"reset_credential_types": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Description: "Other credential types of the user, such as otp or webauthn, that are deleted whenever the password is set.",
+			},
+		},
+	}
+}
+
+// hashUserPassword keys the hash with a random salt of the resource, so that equal passwords do not have equal hashes and the
+// hashes cannot be looked up in precomputed tables
+func hashUserPassword(salt, password string) string {
+	mac := hmac.New(sha256.New, []byte(salt))
+	mac.Write([]byte(password))
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func newUserPasswordSalt() (string, error) {
+	salt := make([]byte, 32)
+	if _, err := rand.Read(salt); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(salt), nil
+}
+
+// the state holds the hash of the password, which is compared with a hash of the configured password
+func suppressUserPasswordDiff(_, old, new string, d *schema.ResourceData) bool {
+	salt := d.Get("password_salt").(string)
+
+	return old != "" && salt != "" && hmac.Equal([]byte(old), []byte(hashUserPassword(salt, new)))
+}
+
+func resourceKeycloakUserPasswordCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	realmId := data.Get("realm_id").(string)
+	userId := data.Get("user_id").(string)
+
+	diagnostics := resourceKeycloakUserPasswordUpdate(ctx, data, meta)
+	if diagnostics.HasError() {
+		return diagnostics
+	}
+
+	data.SetId(userPasswordId(realmId, userId))
+
+	return resourceKeycloakUserPasswordRead(ctx, data, meta)
+}
+
+func resourceKeycloakUserPasswordRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	userId := data.Get("user_id").(string)
+
+	credentials, err := keycloakClient.GetUserCredentials(ctx, realmId, userId)
+	if err != nil {
+		return handleNotFoundError(ctx, err, data)
+	}
+
+	// Keycloak never returns the password itself, so the only drift that can be detected is a removed password
+	for _, credential := range credentials {
+		if credential.Type == "password" {
+			return nil
+		}
+	}
+
+	data.SetId("")
+
+	return nil
+}
+
+func resourceKeycloakUserPasswordUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	userId := data.Get("user_id").(string)
+
+	// reset_credential_types on its own only affects future password changes
+	if data.Id() != "" && !data.HasChanges("password", "temporary", "rotation_trigger") {
+		return nil
+	}
+
+	// the state only holds a hash, and an unchanged password is not part of the diff, so it is read from the configuration
+	password := data.GetRawConfig().GetAttr("password").AsString()
+
+	err := keycloakClient.ResetUserPassword(ctx, realmId, userId, password, data.Get("temporary").(bool))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	salt := data.Get("password_salt").(string)
+	if salt == "" {
+		salt, err = newUserPasswordSalt()
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	data.Set("password_salt", salt)
+	data.Set("password", hashUserPassword(salt, password))
+
+	for _, credentialType := range data.Get("reset_credential_types").(*schema.Set).List() {
+		err = keycloakClient.DeleteUserCredentialsByType(ctx, realmId, userId, credentialType.(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+func resourceKeycloakUserPasswordDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	userId := data.Get("user_id").(string)
+
+	err := keycloakClient.DeleteUserCredentialsByType(ctx, realmId, userId, "password")
+	if err != nil && !keycloak.ErrorIs404(err) {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func userPasswordId(realmId, userId string) string {
+	return fmt.Sprintf("%s/%s", realmId, userId)
+}
@@ -0,0 +1,176 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"testing"
+)
+
+func TestAccKeycloakUserPassword_basic(t *testing.T) {
+	t.Parallel()
+	username := acctest.RandomWithPrefix("tf-acc")
+	password := acctest.RandomWithPrefix("tf-acc")
+	clientId := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakUserDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakUserPassword_basic(username, password, clientId, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakUserInitialPasswordLogin(username, password, clientId),
+					func(s *terraform.State) error {
+						attributes := s.RootModule().Resources["keycloak_user_password.password"].Primary.Attributes
+						if attributes["password"] != hashUserPassword(attributes["password_salt"], password) {
+							return fmt.Errorf("expected the state to hold a salted hash of the password")
+						}
+
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakUserPassword_rotate(t *testing.T) {
+	t.Parallel()
+	username := acctest.RandomWithPrefix("tf-acc")
+	passwordOne := acctest.RandomWithPrefix("tf-acc")
+	passwordTwo := acctest.RandomWithPrefix("tf-acc")
+	clientId := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakUserDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakUserPassword_basic(username, passwordOne, clientId, "1"),
+				Check:  testAccCheckKeycloakUserInitialPasswordLogin(username, passwordOne, clientId),
+			},
+			{
+				Config: testKeycloakUserPassword_basic(username, passwordTwo, clientId, "1"),
+				Check:  testAccCheckKeycloakUserInitialPasswordLogin(username, passwordTwo, clientId),
+			},
+			{
+				// changing the password outside of terraform is only reverted when the trigger changes
+				PreConfig: func() {
+					user, err := keycloakClient.GetUserByUsername(testCtx, testAccRealm.Realm, username)
+					if err != nil {
+						t.Fatal(err)
+					}
+
+					err = keycloakClient.ResetUserPassword(testCtx, testAccRealm.Realm, user.Id, passwordOne, false)
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testKeycloakUserPassword_basic(username, passwordTwo, clientId, "2"),
+				Check:  testAccCheckKeycloakUserInitialPasswordLogin(username, passwordTwo, clientId),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakUserPassword_destroy(t *testing.T) {
+	t.Parallel()
+	username := acctest.RandomWithPrefix("tf-acc")
+	password := acctest.RandomWithPrefix("tf-acc")
+	clientId := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakUserDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakUserPassword_basic(username, password, clientId, "1"),
+				Check:  testAccCheckKeycloakUserInitialPasswordLogin(username, password, clientId),
+			},
+			{
+				Config: testKeycloakUserPassword_userOnly(username, clientId),
+				Check:  testAccCheckKeycloakUserHasNoCredentialOfType("keycloak_user.user", "password"),
+			},
+		},
+	})
+}
+
+func testAccCheckKeycloakUserHasNoCredentialOfType(resourceName, credentialType string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		user, err := getUserFromState(s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		credentials, err := keycloakClient.GetUserCredentials(testCtx, user.RealmId, user.Id)
+		if err != nil {
+			return err
+		}
+
+		for _, credential := range credentials {
+			if credential.Type == credentialType {
+				return fmt.Errorf("expected user %s to have no %s credential", user.Username, credentialType)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testKeycloakUserPassword_basic(username, password, clientId, trigger string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_openid_client" "client" {
+	realm_id  = data.keycloak_realm.realm.id
+	client_id = "%s"
+
+	access_type                  = "PUBLIC"
+	direct_access_grants_enabled = true
+}
+
+resource "keycloak_user" "user" {
+	realm_id = data.keycloak_realm.realm.id
+	username = "%s"
+}
+
+resource "keycloak_user_password" "password" {
+	realm_id = data.keycloak_realm.realm.id
+	user_id  = keycloak_user.user.id
+	password = "%s"
+
+	rotation_trigger = {
+		version = "%s"
+	}
+	reset_credential_types = ["otp"]
+}
+	`, testAccRealm.Realm, clientId, username, password, trigger)
+}
+
+func testKeycloakUserPassword_userOnly(username, clientId string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_openid_client" "client" {
+	realm_id  = data.keycloak_realm.realm.id
+	client_id = "%s"
+
+	access_type                  = "PUBLIC"
+	direct_access_grants_enabled = true
+}
+
+resource "keycloak_user" "user" {
+	realm_id = data.keycloak_realm.realm.id
+	username = "%s"
+}
+	`, testAccRealm.Realm, clientId, username)
+}
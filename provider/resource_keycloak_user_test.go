@@ -287,6 +287,77 @@ func TestAccKeycloakUser_federatedLink(t *testing.T) {
 	})
 }
 
+func TestAccKeycloakUser_attributeBlocks(t *testing.T) {
+	t.Parallel()
+	username := acctest.RandomWithPrefix("tf-acc")
+
+	resourceName := "keycloak_user.user"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakUserDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakUser_attributeBlocks(username),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakUserAttributeValues(resourceName, "phone", []string{"555-1234", "555-5678"}),
+					testAccCheckKeycloakUserAttributeValues(resourceName, "note", []string{"contains ## separator"}),
+					resource.TestCheckResourceAttr(resourceName, "attribute.#", "2"),
+					resource.TestCheckNoResourceAttr(resourceName, "attributes.%"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakUser_attributeUserProfileValidation(t *testing.T) {
+	skipIfVersionIsLessThanOrEqualTo(testCtx, t, keycloakClient, keycloak.Version_14)
+
+	t.Parallel()
+	realmName := acctest.RandomWithPrefix("tf-acc")
+	username := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakUserDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakUser_userProfile(realmName, username, ""),
+			},
+			{
+				Config:      testKeycloakUser_userProfile(realmName, username, "attribute {\n\t\tname   = \"nickname\"\n\t\tvalues = [\"bob\"]\n\t}"),
+				ExpectError: regexp.MustCompile("attribute department is required by the user profile of this realm"),
+			},
+			{
+				Config:      testKeycloakUser_userProfile(realmName, username, "attribute {\n\t\tname   = \"department\"\n\t\tvalues = [\"engineering\"]\n\t}"),
+				ExpectError: regexp.MustCompile("value engineering must be at most 5 characters long"),
+			},
+			{
+				Config: testKeycloakUser_userProfile(realmName, username, "attribute {\n\t\tname   = \"department\"\n\t\tvalues = [\"eng\"]\n\t}"),
+				Check:  testAccCheckKeycloakUserAttributeValues("keycloak_user.user.0", "department", []string{"eng"}),
+			},
+		},
+	})
+}
+
+func testAccCheckKeycloakUserAttributeValues(resourceName, attributeName string, expectedValues []string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		user, err := getUserFromState(s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		values := user.Attributes[attributeName]
+		if strings.Join(values, ",") != strings.Join(expectedValues, ",") {
+			return fmt.Errorf("expected attribute %s of user %s to have values %v, got %v", attributeName, user.Username, expectedValues, values)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckKeycloakUserHasFederationLinkWithSourceUserName(resourceName, sourceUserName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		fetchedUser, err := getUserFromState(s, resourceName)
@@ -423,6 +494,93 @@ resource "keycloak_user" "user" {
 	`, testAccRealm.Realm, username, attributeName, attributeValue)
 }
 
+func testKeycloakUser_attributeBlocks(username string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_user" "user" {
+	realm_id = data.keycloak_realm.realm.id
+	username = "%s"
+
+	attribute {
+		name   = "phone"
+		values = ["555-1234", "555-5678"]
+	}
+
+	attribute {
+		name   = "note"
+		values = ["contains ## separator"]
+	}
+}
+	`, testAccRealm.Realm, username)
+}
+
+// the user is only created when an attribute block is given, so that the first step only sets up the user profile
+func testKeycloakUser_userProfile(realmName, username, attributeBlock string) string {
+	count := 0
+	if attributeBlock != "" {
+		count = 1
+	}
+
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+
+	attributes = {
+		userProfileEnabled = true
+	}
+}
+
+resource "keycloak_realm_user_profile" "realm_user_profile" {
+	realm_id = keycloak_realm.realm.id
+
+	attribute {
+		name = "username"
+	}
+
+	attribute {
+		name = "email"
+	}
+
+	attribute {
+		name               = "department"
+		required_for_roles = ["admin"]
+
+		permissions {
+			view = ["admin"]
+			edit = ["admin"]
+		}
+
+		validator {
+			name   = "length"
+			config = {
+				max = "5"
+			}
+		}
+	}
+
+	attribute {
+		name = "nickname"
+
+		permissions {
+			view = ["admin"]
+			edit = ["admin"]
+		}
+	}
+}
+
+resource "keycloak_user" "user" {
+	count    = %d
+	realm_id = keycloak_realm_user_profile.realm_user_profile.realm_id
+	username = "%s"
+
+	%s
+}
+	`, realmName, count, username, attributeBlock)
+}
+
 func testKeycloakUser_initialPassword(username string, password string, clientId string) string {
 	return fmt.Sprintf(`
 data "keycloak_realm" "realm" {
@@ -0,0 +1,899 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+)
+
+var (
+	keycloakUsersBulkSources    = []string{"user", "users_json", "users_csv"}
+	keycloakUsersBulkCsvColumns = []string{"username", "email", "email_verified", "first_name", "last_name", "enabled", "groups", "realm_roles", "client_roles"}
+)
+
+func resourceKeycloakUsersBulk() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceKeycloakUsersBulkCreate,
+		ReadContext:   resourceKeycloakUsersBulkRead,
+		DeleteContext: resourceKeycloakUsersBulkDelete,
+		UpdateContext: resourceKeycloakUsersBulkUpdate,
+		CustomizeDiff: resourceKeycloakUsersBulkCustomizeDiff,
+		Schema: map[string]*schema.Schema{
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"user": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				ExactlyOneOf: keycloakUsersBulkSources,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"username": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"email": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"email_verified": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"first_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"last_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"attributes": {
+							Type:     schema.TypeMap,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Optional: true,
+						},
+						"groups": {
+							Type:     schema.TypeSet,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Optional: true,
+						},
+						"realm_roles": {
+							Type:     schema.TypeSet,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Optional: true,
+						},
+						"client_roles": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"client_id": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"roles": {
+										Type:     schema.TypeSet,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"users_json": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: keycloakUsersBulkSources,
+				ValidateFunc: validation.StringIsJSON,
+			},
+			"users_csv": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: keycloakUsersBulkSources,
+			},
+			"batch_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      500,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			// a hash of every user as it was last seen in Keycloak, keyed by username
+			"user_hashes": {
+				Type:     schema.TypeMap,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Computed: true,
+			},
+			// the users this resource created. only these are updated and deleted, so users that existed before are never touched
+			"created_usernames": {
+				Type:     schema.TypeSet,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Computed: true,
+			},
+		},
+	}
+}
+
+type bulkUsersGetter interface {
+	Get(string) interface{}
+}
+
+// bulkUserJson is a user in users_json, enabled is a pointer so that users are enabled unless told otherwise
+type bulkUserJson struct {
+	keycloak.PartialImportUser
+	Enabled *bool `json:"enabled"`
+}
+
+func getBulkUsersFromData(data bulkUsersGetter) ([]*keycloak.PartialImportUser, error) {
+	var users []*keycloak.PartialImportUser
+
+	if v := data.Get("users_json").(string); v != "" {
+		var jsonUsers []*bulkUserJson
+		err := json.Unmarshal([]byte(v), &jsonUsers)
+		if err != nil {
+			return nil, fmt.Errorf("users_json must be a list of users: %s", err)
+		}
+
+		for _, jsonUser := range jsonUsers {
+			user := jsonUser.PartialImportUser
+			user.Enabled = jsonUser.Enabled == nil || *jsonUser.Enabled
+			users = append(users, &user)
+		}
+	} else if v := data.Get("users_csv").(string); v != "" {
+		csvUsers, err := getBulkUsersFromCsv(v)
+		if err != nil {
+			return nil, err
+		}
+
+		users = csvUsers
+	} else {
+		for _, u := range data.Get("user").([]interface{}) {
+			userData := u.(map[string]interface{})
+
+			user := &keycloak.PartialImportUser{
+				Username:      userData["username"].(string),
+				Email:         userData["email"].(string),
+				EmailVerified: userData["email_verified"].(bool),
+				FirstName:     userData["first_name"].(string),
+				LastName:      userData["last_name"].(string),
+				Enabled:       userData["enabled"].(bool),
+				Attributes:    map[string][]string{},
+				Groups:        interfaceSliceToStringSlice(userData["groups"].(*schema.Set).List()),
+				RealmRoles:    interfaceSliceToStringSlice(userData["realm_roles"].(*schema.Set).List()),
+				ClientRoles:   map[string][]string{},
+			}
+
+			for key, value := range userData["attributes"].(map[string]interface{}) {
+				user.Attributes[key] = strings.Split(value.(string), MULTIVALUE_ATTRIBUTE_SEPARATOR)
+			}
+
+			for _, c := range userData["client_roles"].([]interface{}) {
+				clientRoles := c.(map[string]interface{})
+				clientId := clientRoles["client_id"].(string)
+
+				user.ClientRoles[clientId] = append(user.ClientRoles[clientId], interfaceSliceToStringSlice(clientRoles["roles"].(*schema.Set).List())...)
+			}
+
+			users = append(users, user)
+		}
+	}
+
+	seen := map[string]bool{}
+	for _, user := range users {
+		if user.Username == "" {
+			return nil, fmt.Errorf("every user needs a username")
+		}
+
+		normalizeBulkUser(user)
+
+		if seen[user.Username] {
+			return nil, fmt.Errorf("user %s is given more than once", user.Username)
+		}
+		seen[user.Username] = true
+	}
+
+	return users, nil
+}
+
+// getBulkUsersFromCsv reads users from a csv document with a header row. Columns named attributes.<name> hold attributes, and the
+// groups, realm_roles and client_roles columns hold multiple values separated by ##, client roles are written as client_id:role
+func getBulkUsersFromCsv(document string) ([]*keycloak.PartialImportUser, error) {
+	records, err := csv.NewReader(strings.NewReader(document)).ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("users_csv is not a valid csv document: %s", err)
+	}
+	if len(records) == 0 {
+		return nil, nil
+	}
+
+	header := records[0]
+	for _, column := range header {
+		if !stringSliceContains(keycloakUsersBulkCsvColumns, column) && !strings.HasPrefix(column, "attributes.") {
+			return nil, fmt.Errorf("unknown column %s in users_csv", column)
+		}
+	}
+
+	var users []*keycloak.PartialImportUser
+
+	for _, record := range records[1:] {
+		user := &keycloak.PartialImportUser{
+			Enabled:     true,
+			Attributes:  map[string][]string{},
+			ClientRoles: map[string][]string{},
+		}
+
+		for i, column := range header {
+			value := record[i]
+			if value == "" {
+				continue
+			}
+
+			values := strings.Split(value, MULTIVALUE_ATTRIBUTE_SEPARATOR)
+
+			switch {
+			case column == "username":
+				user.Username = value
+			case column == "email":
+				user.Email = value
+			case column == "first_name":
+				user.FirstName = value
+			case column == "last_name":
+				user.LastName = value
+			case column == "email_verified", column == "enabled":
+				b, err := strconv.ParseBool(value)
+				if err != nil {
+					return nil, fmt.Errorf("column %s of users_csv must be true or false, got %s", column, value)
+				}
+				if column == "enabled" {
+					user.Enabled = b
+				} else {
+					user.EmailVerified = b
+				}
+			case column == "groups":
+				user.Groups = values
+			case column == "realm_roles":
+				user.RealmRoles = values
+			case column == "client_roles":
+				for _, clientRole := range values {
+					parts := strings.SplitN(clientRole, ":", 2)
+					if len(parts) != 2 {
+						return nil, fmt.Errorf("client roles in users_csv must be written as client_id:role, got %s", clientRole)
+					}
+					user.ClientRoles[parts[0]] = append(user.ClientRoles[parts[0]], parts[1])
+				}
+			default:
+				user.Attributes[strings.TrimPrefix(column, "attributes.")] = values
+			}
+		}
+
+		users = append(users, user)
+	}
+
+	return users, nil
+}
+
+// normalizeBulkUser puts a user in the form Keycloak stores it in, so that hashes of configured and imported users can be compared
+func normalizeBulkUser(user *keycloak.PartialImportUser) {
+	user.Username = strings.ToLower(user.Username)
+	user.Email = strings.ToLower(user.Email)
+
+	for key, values := range user.Attributes {
+		if len(values) == 0 {
+			delete(user.Attributes, key)
+		}
+	}
+	for clientId, roles := range user.ClientRoles {
+		if len(roles) == 0 {
+			delete(user.ClientRoles, clientId)
+			continue
+		}
+		sort.Strings(roles)
+	}
+
+	sort.Strings(user.Groups)
+	sort.Strings(user.RealmRoles)
+}
+
+func hashBulkUser(user *keycloak.PartialImportUser) string {
+	// encoding/json sorts map keys, which makes the encoding stable
+	b, _ := json.Marshal(user)
+	sum := sha256.Sum256(b)
+
+	return hex.EncodeToString(sum[:])
+}
+
+func getBulkUserHashes(users []*keycloak.PartialImportUser) map[string]interface{} {
+	hashes := map[string]interface{}{}
+	for _, user := range users {
+		hashes[user.Username] = hashBulkUser(user)
+	}
+
+	return hashes
+}
+
+// importBulkUsers creates the given users in batches. Users that already exist fail the batch they are in, and the usernames of
+// every batch that was imported before a failure are returned along with the error, so that they can be kept track of.
+func importBulkUsers(ctx context.Context, keycloakClient *keycloak.KeycloakClient, realmId string, users []*keycloak.PartialImportUser, batchSize int) ([]string, error) {
+	var importedUsernames []string
+
+	for start := 0; start < len(users); start += batchSize {
+		end := start + batchSize
+		if end > len(users) {
+			end = len(users)
+		}
+
+		_, err := keycloakClient.PartialImport(ctx, realmId, &keycloak.PartialImport{
+			IfResourceExists: keycloak.PartialImportIfResourceExistsFail,
+			Users:            users[start:end],
+		})
+		if err != nil {
+			return importedUsernames, err
+		}
+
+		importedUsernames = append(importedUsernames, getBulkUsernames(users[start:end])...)
+	}
+
+	return importedUsernames, nil
+}
+
+// deleteBulkUsers deletes the given users, and returns the usernames of the users that are left when it fails
+func deleteBulkUsers(ctx context.Context, keycloakClient *keycloak.KeycloakClient, realmId string, existingUsers map[string]*keycloak.User, usernames []string) ([]string, error) {
+	for i, username := range usernames {
+		user, ok := existingUsers[username]
+		if !ok {
+			continue
+		}
+
+		err := keycloakClient.DeleteUser(ctx, realmId, user.Id)
+		if err != nil && !keycloak.ErrorIs404(err) {
+			return usernames[i:], err
+		}
+	}
+
+	return nil, nil
+}
+
+// getBulkUsersByUsername pages through every user of the realm once and picks the given users from it, which takes far fewer
+// requests than looking up each user on its own
+func getBulkUsersByUsername(ctx context.Context, keycloakClient *keycloak.KeycloakClient, realmId string, usernames []string) (map[string]*keycloak.User, error) {
+	usersByUsername := map[string]*keycloak.User{}
+	if len(usernames) == 0 {
+		return usersByUsername, nil
+	}
+
+	wanted := map[string]bool{}
+	for _, username := range usernames {
+		wanted[username] = true
+	}
+
+	users, err := keycloakClient.SearchUsers(ctx, realmId, map[string]string{"briefRepresentation": "false"}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, user := range users {
+		if wanted[user.Username] {
+			usersByUsername[user.Username] = user
+		}
+	}
+
+	return usersByUsername, nil
+}
+
+func getBulkCreatedUsernames(data *schema.ResourceData) map[string]bool {
+	createdUsernames := map[string]bool{}
+	for _, username := range data.Get("created_usernames").(*schema.Set).List() {
+		createdUsernames[username.(string)] = true
+	}
+
+	return createdUsernames
+}
+
+func setBulkCreatedUsernames(data *schema.ResourceData, createdUsernames map[string]bool) {
+	var usernames []string
+	for username := range createdUsernames {
+		usernames = append(usernames, username)
+	}
+
+	data.Set("created_usernames", usernames)
+}
+
+func getBulkUsernames(users []*keycloak.PartialImportUser) []string {
+	var usernames []string
+	for _, user := range users {
+		usernames = append(usernames, user.Username)
+	}
+
+	return usernames
+}
+
+// getImportedBulkUsers reads the configured users that were created by the resource back from Keycloak. Only the attributes,
+// groups and roles that appear in the configuration are compared, a user that was added to an unrelated group or role is not
+// considered to have drifted. Groups and roles are only fetched for users that have them configured.
+func getImportedBulkUsers(ctx context.Context, keycloakClient *keycloak.KeycloakClient, realmId string, configuredUsers []*keycloak.PartialImportUser, createdUsernames map[string]bool) ([]*keycloak.PartialImportUser, error) {
+	var usernames []string
+	for _, configuredUser := range configuredUsers {
+		if createdUsernames[configuredUser.Username] {
+			usernames = append(usernames, configuredUser.Username)
+		}
+	}
+
+	existingUsers, err := getBulkUsersByUsername(ctx, keycloakClient, realmId, usernames)
+	if err != nil {
+		return nil, err
+	}
+
+	var importedUsers []*keycloak.PartialImportUser
+
+	for _, configuredUser := range configuredUsers {
+		existingUser, ok := existingUsers[configuredUser.Username]
+		if !ok {
+			continue
+		}
+
+		importedUser := &keycloak.PartialImportUser{
+			Username:      existingUser.Username,
+			Email:         existingUser.Email,
+			EmailVerified: existingUser.EmailVerified,
+			FirstName:     existingUser.FirstName,
+			LastName:      existingUser.LastName,
+			Enabled:       existingUser.Enabled,
+			Attributes:    map[string][]string{},
+			ClientRoles:   map[string][]string{},
+		}
+
+		for key := range configuredUser.Attributes {
+			if values, ok := existingUser.Attributes[key]; ok {
+				importedUser.Attributes[key] = values
+			}
+		}
+
+		if len(configuredUser.Groups) != 0 {
+			groups, err := keycloakClient.GetUserGroups(ctx, realmId, existingUser.Id)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, group := range groups {
+				if stringSliceContains(configuredUser.Groups, group.Path) {
+					importedUser.Groups = append(importedUser.Groups, group.Path)
+				}
+			}
+		}
+
+		if len(configuredUser.RealmRoles) != 0 || len(configuredUser.ClientRoles) != 0 {
+			roleMapping, err := keycloakClient.GetUserRoleMappings(ctx, realmId, existingUser.Id)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, role := range roleMapping.RealmMappings {
+				if stringSliceContains(configuredUser.RealmRoles, role.Name) {
+					importedUser.RealmRoles = append(importedUser.RealmRoles, role.Name)
+				}
+			}
+
+			// client mappings are keyed by the client id, which is how client roles are configured as well
+			for clientId, clientRoleMapping := range roleMapping.ClientMappings {
+				for _, role := range clientRoleMapping.Mappings {
+					if stringSliceContains(configuredUser.ClientRoles[clientId], role.Name) {
+						importedUser.ClientRoles[clientId] = append(importedUser.ClientRoles[clientId], role.Name)
+					}
+				}
+			}
+		}
+
+		normalizeBulkUser(importedUser)
+
+		importedUsers = append(importedUsers, importedUser)
+	}
+
+	return importedUsers, nil
+}
+
+// updateBulkUser updates an existing user in place, which keeps its id, credentials and sessions. Attributes, groups and roles
+// that were configured before but no longer are get removed, the ones that were given outside of Terraform are left alone.
+func updateBulkUser(ctx context.Context, keycloakClient *keycloak.KeycloakClient, realmId string, existingUser *keycloak.User, user, oldUser *keycloak.PartialImportUser) error {
+	if oldUser == nil {
+		oldUser = &keycloak.PartialImportUser{}
+	}
+
+	existingUser.RealmId = realmId
+	existingUser.Email = user.Email
+	existingUser.EmailVerified = user.EmailVerified
+	existingUser.FirstName = user.FirstName
+	existingUser.LastName = user.LastName
+	existingUser.Enabled = user.Enabled
+	existingUser.FederatedIdentities = nil
+
+	if existingUser.Attributes == nil {
+		existingUser.Attributes = map[string][]string{}
+	}
+	for key := range oldUser.Attributes {
+		if _, ok := user.Attributes[key]; !ok {
+			delete(existingUser.Attributes, key)
+		}
+	}
+	for key, values := range user.Attributes {
+		existingUser.Attributes[key] = values
+	}
+
+	err := keycloakClient.UpdateUser(ctx, existingUser)
+	if err != nil {
+		return err
+	}
+
+	err = updateBulkUserGroups(ctx, keycloakClient, realmId, existingUser.Id, user.Groups, oldUser.Groups)
+	if err != nil {
+		return err
+	}
+
+	return updateBulkUserRoles(ctx, keycloakClient, realmId, existingUser.Id, user, oldUser)
+}
+
+func updateBulkUserGroups(ctx context.Context, keycloakClient *keycloak.KeycloakClient, realmId, userId string, groups, oldGroups []string) error {
+	if len(groups) == 0 && len(oldGroups) == 0 {
+		return nil
+	}
+
+	currentGroups, err := keycloakClient.GetUserGroups(ctx, realmId, userId)
+	if err != nil {
+		return err
+	}
+
+	currentGroupIds := map[string]string{}
+	for _, group := range currentGroups {
+		currentGroupIds[group.Path] = group.Id
+	}
+
+	var groupIdsToAdd, groupIdsToRemove []string
+	for _, path := range groups {
+		if _, ok := currentGroupIds[path]; ok {
+			continue
+		}
+
+		group, err := keycloakClient.GetGroupByPath(ctx, realmId, path)
+		if err != nil {
+			return err
+		}
+
+		groupIdsToAdd = append(groupIdsToAdd, group.Id)
+	}
+	for _, path := range oldGroups {
+		if groupId, ok := currentGroupIds[path]; ok && !stringSliceContains(groups, path) {
+			groupIdsToRemove = append(groupIdsToRemove, groupId)
+		}
+	}
+
+	err = keycloakClient.AddUserToGroups(ctx, groupIdsToAdd, userId, realmId)
+	if err != nil {
+		return err
+	}
+
+	return keycloakClient.RemoveUserFromGroups(ctx, groupIdsToRemove, userId, realmId)
+}
+
+func updateBulkUserRoles(ctx context.Context, keycloakClient *keycloak.KeycloakClient, realmId, userId string, user, oldUser *keycloak.PartialImportUser) error {
+	if len(user.RealmRoles) == 0 && len(oldUser.RealmRoles) == 0 && len(user.ClientRoles) == 0 && len(oldUser.ClientRoles) == 0 {
+		return nil
+	}
+
+	roleMapping, err := keycloakClient.GetUserRoleMappings(ctx, realmId, userId)
+	if err != nil {
+		return err
+	}
+
+	rolesToAdd, rolesToRemove, err := getBulkUserRoleChanges(ctx, keycloakClient, realmId, "", roleMapping.RealmMappings, user.RealmRoles, oldUser.RealmRoles)
+	if err != nil {
+		return err
+	}
+	if len(rolesToAdd) != 0 {
+		err = keycloakClient.AddRealmRolesToUser(ctx, realmId, userId, rolesToAdd)
+		if err != nil {
+			return err
+		}
+	}
+	if len(rolesToRemove) != 0 {
+		err = keycloakClient.RemoveRealmRolesFromUser(ctx, realmId, userId, rolesToRemove)
+		if err != nil {
+			return err
+		}
+	}
+
+	clientIds := map[string]bool{}
+	for clientId := range user.ClientRoles {
+		clientIds[clientId] = true
+	}
+	for clientId := range oldUser.ClientRoles {
+		clientIds[clientId] = true
+	}
+
+	for clientId := range clientIds {
+		client, err := keycloakClient.GetGenericClientByClientId(ctx, realmId, clientId)
+		if err != nil {
+			return err
+		}
+
+		var currentRoles []*keycloak.Role
+		if clientRoleMapping, ok := roleMapping.ClientMappings[clientId]; ok {
+			currentRoles = clientRoleMapping.Mappings
+		}
+
+		rolesToAdd, rolesToRemove, err := getBulkUserRoleChanges(ctx, keycloakClient, realmId, client.Id, currentRoles, user.ClientRoles[clientId], oldUser.ClientRoles[clientId])
+		if err != nil {
+			return err
+		}
+		if len(rolesToAdd) != 0 {
+			err = keycloakClient.AddClientRolesToUser(ctx, realmId, userId, client.Id, rolesToAdd)
+			if err != nil {
+				return err
+			}
+		}
+		if len(rolesToRemove) != 0 {
+			err = keycloakClient.RemoveClientRolesFromUser(ctx, realmId, userId, client.Id, rolesToRemove)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// getBulkUserRoleChanges returns the configured roles the user is missing, and the roles the user has that are no longer configured
+func getBulkUserRoleChanges(ctx context.Context, keycloakClient *keycloak.KeycloakClient, realmId, clientId string, currentRoles []*keycloak.Role, roles, oldRoles []string) ([]*keycloak.Role, []*keycloak.Role, error) {
+	currentRolesByName := map[string]*keycloak.Role{}
+	for _, role := range currentRoles {
+		currentRolesByName[role.Name] = role
+	}
+
+	var rolesToAdd, rolesToRemove []*keycloak.Role
+	for _, name := range roles {
+		if _, ok := currentRolesByName[name]; ok {
+			continue
+		}
+
+		role, err := keycloakClient.GetRoleByName(ctx, realmId, clientId, name)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		rolesToAdd = append(rolesToAdd, role)
+	}
+	for _, name := range oldRoles {
+		if role, ok := currentRolesByName[name]; ok && !stringSliceContains(roles, name) {
+			rolesToRemove = append(rolesToRemove, role)
+		}
+	}
+
+	return rolesToAdd, rolesToRemove, nil
+}
+
+func resourceKeycloakUsersBulkCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	for _, key := range keycloakUsersBulkSources {
+		if !diff.NewValueKnown(key) {
+			return diff.SetNewComputed("user_hashes")
+		}
+	}
+
+	users, err := getBulkUsersFromData(diff)
+	if err != nil {
+		return err
+	}
+
+	hashes := getBulkUserHashes(users)
+
+	// only touching user_hashes when a user changed keeps the plan empty otherwise
+	oldHashes := diff.Get("user_hashes").(map[string]interface{})
+	if len(oldHashes) == len(hashes) {
+		changed := false
+		for username, hash := range hashes {
+			if oldHashes[username] != hash {
+				changed = true
+				break
+			}
+		}
+		if !changed {
+			return nil
+		}
+	}
+
+	err = diff.SetNew("user_hashes", hashes)
+	if err != nil {
+		return err
+	}
+
+	return diff.SetNewComputed("created_usernames")
+}
+
+func resourceKeycloakUsersBulkCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+
+	users, err := getBulkUsersFromData(data)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	importedUsernames, err := importBulkUsers(ctx, keycloakClient, realmId, users, data.Get("batch_size").(int))
+
+	// the id is only set once a batch was imported, so that nothing is saved when the first batch fails, and the users of the
+	// batches that were imported before a later failure are kept in the state and cleaned up when the resource is replaced
+	if err == nil || len(importedUsernames) != 0 {
+		data.SetId(fmt.Sprintf("%s/%s", realmId, id.UniqueId()))
+		data.Set("created_usernames", importedUsernames)
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceKeycloakUsersBulkRead(ctx, data, meta)
+}
+
+func resourceKeycloakUsersBulkRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+
+	users, err := getBulkUsersFromData(data)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	importedUsers, err := getImportedBulkUsers(ctx, keycloakClient, realmId, users, getBulkCreatedUsernames(data))
+	if err != nil {
+		return handleNotFoundError(ctx, err, data)
+	}
+
+	data.Set("user_hashes", getBulkUserHashes(importedUsers))
+
+	return nil
+}
+
+func resourceKeycloakUsersBulkUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+
+	users, err := getBulkUsersFromData(data)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	oldHashesData, _ := data.GetChange("user_hashes")
+	oldHashes := oldHashesData.(map[string]interface{})
+
+	oldCreatedUsernamesData, _ := data.GetChange("created_usernames")
+	createdUsernames := map[string]bool{}
+	for _, username := range oldCreatedUsernamesData.(*schema.Set).List() {
+		createdUsernames[username.(string)] = true
+	}
+
+	// only users that are new or that changed, either in the configuration or in Keycloak, are synced again
+	var changedUsers []*keycloak.PartialImportUser
+	configuredUsernames := map[string]bool{}
+	for _, user := range users {
+		configuredUsernames[user.Username] = true
+
+		if oldHashes[user.Username] != hashBulkUser(user) {
+			changedUsers = append(changedUsers, user)
+		}
+	}
+
+	oldUsers, err := getBulkUsersFromData(&bulkUsersChange{data: data})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	oldUsersByUsername := map[string]*keycloak.PartialImportUser{}
+	for _, user := range oldUsers {
+		oldUsersByUsername[user.Username] = user
+	}
+
+	// users that are no longer configured are only deleted when this resource created them
+	var removedUsernames []string
+	for username := range createdUsernames {
+		if !configuredUsernames[username] {
+			removedUsernames = append(removedUsernames, username)
+		}
+	}
+
+	existingUsers, err := getBulkUsersByUsername(ctx, keycloakClient, realmId, append(removedUsernames, getBulkUsernames(changedUsers)...))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	remainingUsernames, err := deleteBulkUsers(ctx, keycloakClient, realmId, existingUsers, removedUsernames)
+	for _, username := range removedUsernames {
+		delete(createdUsernames, username)
+	}
+	for _, username := range remainingUsernames {
+		createdUsernames[username] = true
+	}
+	if err != nil {
+		setBulkCreatedUsernames(data, createdUsernames)
+		return diag.FromErr(err)
+	}
+
+	// users that this resource created are updated in place, importing them again would replace them with a new user. every
+	// other user is imported, which fails for users that already exist instead of taking them over
+	var newUsers []*keycloak.PartialImportUser
+	for _, user := range changedUsers {
+		existingUser, ok := existingUsers[user.Username]
+		if !ok || !createdUsernames[user.Username] {
+			newUsers = append(newUsers, user)
+			continue
+		}
+
+		err = updateBulkUser(ctx, keycloakClient, realmId, existingUser, user, oldUsersByUsername[user.Username])
+		if err != nil {
+			setBulkCreatedUsernames(data, createdUsernames)
+			return diag.FromErr(err)
+		}
+	}
+
+	importedUsernames, err := importBulkUsers(ctx, keycloakClient, realmId, newUsers, data.Get("batch_size").(int))
+	for _, username := range importedUsernames {
+		createdUsernames[username] = true
+	}
+	setBulkCreatedUsernames(data, createdUsernames)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceKeycloakUsersBulkRead(ctx, data, meta)
+}
+
+// bulkUsersChange reads the users that were configured before an update
+type bulkUsersChange struct {
+	data *schema.ResourceData
+}
+
+func (c *bulkUsersChange) Get(key string) interface{} {
+	old, _ := c.data.GetChange(key)
+
+	return old
+}
+
+func resourceKeycloakUsersBulkDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+
+	var createdUsernames []string
+	for username := range getBulkCreatedUsernames(data) {
+		createdUsernames = append(createdUsernames, username)
+	}
+
+	existingUsers, err := getBulkUsersByUsername(ctx, keycloakClient, realmId, createdUsernames)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, err = deleteBulkUsers(ctx, keycloakClient, realmId, existingUsers, createdUsernames)
+
+	return diag.FromErr(err)
+}
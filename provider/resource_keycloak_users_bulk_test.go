@@ -0,0 +1,382 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/mrparkers/terraform-provider-keycloak/keycloak"
+)
+
+func TestAccKeycloakUsersBulk_basic(t *testing.T) {
+	t.Parallel()
+	prefix := acctest.RandomWithPrefix("tf-acc")
+	userId := ""
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakUsersBulkDestroy(prefix, 3),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakUsersBulk_basic(prefix, "finance"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("keycloak_users_bulk.users", "user_hashes.%", "3"),
+					testAccCheckKeycloakUsersBulkUserInGroup(prefix+"-0", prefix+"-group"),
+					testAccCheckKeycloakUsersBulkUserAttribute(prefix+"-1", "department", "finance"),
+					testAccCheckKeycloakUsersBulkUserIdIsUnchanged(prefix+"-1", &userId),
+				),
+			},
+			{
+				Config: testKeycloakUsersBulk_basic(prefix, "sales"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakUsersBulkUserAttribute(prefix+"-1", "department", "sales"),
+					testAccCheckKeycloakUsersBulkUserIdIsUnchanged(prefix+"-1", &userId),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakUsersBulk_drift(t *testing.T) {
+	t.Parallel()
+	prefix := acctest.RandomWithPrefix("tf-acc")
+	userId := ""
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakUsersBulkDestroy(prefix, 3),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakUsersBulk_basic(prefix, "finance"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakUsersBulkUserInGroup(prefix+"-0", prefix+"-group"),
+					testAccCheckKeycloakUsersBulkUserIdIsUnchanged(prefix+"-0", &userId),
+				),
+			},
+			{
+				PreConfig: func() {
+					user, err := keycloakClient.GetUserByUsername(testCtx, testAccRealm.Realm, prefix+"-0")
+					if err != nil {
+						t.Fatal(err)
+					}
+
+					group, err := keycloakClient.GetGroupByPath(testCtx, testAccRealm.Realm, "/"+prefix+"-group")
+					if err != nil {
+						t.Fatal(err)
+					}
+
+					err = keycloakClient.RemoveUserFromGroup(testCtx, user, group.Id)
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testKeycloakUsersBulk_basic(prefix, "finance"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakUsersBulkUserInGroup(prefix+"-0", prefix+"-group"),
+					testAccCheckKeycloakUsersBulkUserIdIsUnchanged(prefix+"-0", &userId),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakUsersBulk_csv(t *testing.T) {
+	t.Parallel()
+	prefix := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakUsersBulkDestroy(prefix, 2),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakUsersBulk_csv(prefix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("keycloak_users_bulk.users", "user_hashes.%", "2"),
+					testAccCheckKeycloakUsersBulkUserAttribute(prefix+"-1", "department", "finance"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakUsersBulk_json(t *testing.T) {
+	t.Parallel()
+	prefix := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakUsersBulkDestroy(prefix, 2),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakUsersBulk_json(prefix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("keycloak_users_bulk.users", "user_hashes.%", "2"),
+					testAccCheckKeycloakUsersBulkUserAttribute(prefix+"-0", "department", "finance"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakUsersBulk_existingUser(t *testing.T) {
+	t.Parallel()
+	prefix := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy: func(s *terraform.State) error {
+			// users that were not created by the resource must be left alone
+			user, err := keycloakClient.GetUserByUsername(testCtx, testAccRealm.Realm, prefix+"-0")
+			if err != nil {
+				return err
+			}
+			if user == nil {
+				return fmt.Errorf("user %s-0 was deleted", prefix)
+			}
+
+			return keycloakClient.DeleteUser(testCtx, testAccRealm.Realm, user.Id)
+		},
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					err := keycloakClient.NewUser(testCtx, &keycloak.User{
+						RealmId:  testAccRealm.Realm,
+						Username: prefix + "-0",
+						Enabled:  true,
+					})
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config:      testKeycloakUsersBulk_csv(prefix),
+				ExpectError: regexp.MustCompile("already exists"),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakUsersBulk_duplicateUsername(t *testing.T) {
+	t.Parallel()
+	prefix := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config:      testKeycloakUsersBulk_duplicateUsername(prefix),
+				ExpectError: regexp.MustCompile("is given more than once"),
+			},
+		},
+	})
+}
+
+func testAccCheckKeycloakUsersBulkUserInGroup(username, groupName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		user, err := keycloakClient.GetUserByUsername(testCtx, testAccRealm.Realm, username)
+		if err != nil {
+			return err
+		}
+		if user == nil {
+			return fmt.Errorf("user %s does not exist", username)
+		}
+
+		groups, err := keycloakClient.GetUserGroups(testCtx, testAccRealm.Realm, user.Id)
+		if err != nil {
+			return err
+		}
+
+		for _, group := range groups {
+			if group.Name == groupName {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("expected user %s to be a member of group %s", username, groupName)
+	}
+}
+
+func testAccCheckKeycloakUsersBulkUserAttribute(username, attributeName, attributeValue string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		user, err := keycloakClient.GetUserByUsername(testCtx, testAccRealm.Realm, username)
+		if err != nil {
+			return err
+		}
+		if user == nil {
+			return fmt.Errorf("user %s does not exist", username)
+		}
+
+		values := user.Attributes[attributeName]
+		if len(values) != 1 || values[0] != attributeValue {
+			return fmt.Errorf("expected attribute %s of user %s to be %s, got %v", attributeName, username, attributeValue, values)
+		}
+
+		return nil
+	}
+}
+
+// testAccCheckKeycloakUsersBulkUserIdIsUnchanged remembers the id of the user the first time it runs, and makes sure the user
+// was not replaced afterwards
+func testAccCheckKeycloakUsersBulkUserIdIsUnchanged(username string, userId *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		user, err := keycloakClient.GetUserByUsername(testCtx, testAccRealm.Realm, username)
+		if err != nil {
+			return err
+		}
+		if user == nil {
+			return fmt.Errorf("user %s does not exist", username)
+		}
+
+		if *userId == "" {
+			*userId = user.Id
+		} else if *userId != user.Id {
+			return fmt.Errorf("expected user %s to keep id %s, got %s", username, *userId, user.Id)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckKeycloakUsersBulkDestroy(prefix string, count int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for i := 0; i < count; i++ {
+			username := fmt.Sprintf("%s-%d", prefix, i)
+
+			user, _ := keycloakClient.GetUserByUsername(testCtx, testAccRealm.Realm, username)
+			if user != nil {
+				return fmt.Errorf("user %s still exists", username)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testKeycloakUsersBulk_basic(prefix, department string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_group" "group" {
+	realm_id = data.keycloak_realm.realm.id
+	name     = "%s-group"
+}
+
+resource "keycloak_role" "role" {
+	realm_id = data.keycloak_realm.realm.id
+	name     = "%s-role"
+}
+
+resource "keycloak_openid_client" "client" {
+	realm_id    = data.keycloak_realm.realm.id
+	client_id   = "%s-client"
+	access_type = "PUBLIC"
+}
+
+resource "keycloak_role" "client_role" {
+	realm_id  = data.keycloak_realm.realm.id
+	client_id = keycloak_openid_client.client.id
+	name      = "%s-client-role"
+}
+
+resource "keycloak_users_bulk" "users" {
+	realm_id = data.keycloak_realm.realm.id
+
+	user {
+		username    = "%s-0"
+		email       = "%s-0@example.com"
+		groups      = ["/${keycloak_group.group.name}"]
+		realm_roles = [keycloak_role.role.name]
+	}
+
+	user {
+		username = "%s-1"
+
+		attributes = {
+			department = "%s"
+		}
+
+		client_roles {
+			client_id = keycloak_openid_client.client.client_id
+			roles     = [keycloak_role.client_role.name]
+		}
+	}
+
+	user {
+		username = "%s-2"
+		enabled  = false
+	}
+}
+	`, testAccRealm.Realm, prefix, prefix, prefix, prefix, prefix, prefix, prefix, department, prefix)
+}
+
+func testKeycloakUsersBulk_csv(prefix string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_users_bulk" "users" {
+	realm_id  = data.keycloak_realm.realm.id
+	users_csv = <<-EOT
+		username,email,enabled,attributes.department
+		%s-0,%s-0@example.com,true,
+		%s-1,,false,finance
+	EOT
+}
+	`, testAccRealm.Realm, prefix, prefix, prefix)
+}
+
+func testKeycloakUsersBulk_json(prefix string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_users_bulk" "users" {
+	realm_id   = data.keycloak_realm.realm.id
+	batch_size = 1
+	users_json = jsonencode([
+		{
+			username   = "%s-0"
+			attributes = {
+				department = ["finance"]
+			}
+		},
+		{
+			username = "%s-1"
+			enabled  = false
+		},
+	])
+}
+	`, testAccRealm.Realm, prefix, prefix)
+}
+
+func testKeycloakUsersBulk_duplicateUsername(prefix string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_users_bulk" "users" {
+	realm_id = data.keycloak_realm.realm.id
+
+	user {
+		username = "%s"
+	}
+
+	user {
+		username = "%s"
+	}
+}
+	`, testAccRealm.Realm, prefix, prefix)
+}